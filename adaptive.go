@@ -0,0 +1,217 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"os"
+	"slices"
+
+	"github.com/akramarenkov/safe"
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+// Adaptive statistics whose spans are adjusted during collection so that items
+// have approximately equal quantity of occurrences.
+//
+// It is a streaming histogram similar to the one proposed by Ben-Haim and
+// Tom-Tov: a value not belonging to any span creates a new item with a span of
+// this value only, an item whose quantity of occurrences exceeds twice the mean
+// is split in half and, when the quantity of items exceeds the limit, the
+// adjacent pair of items with the smallest total quantity of occurrences is
+// merged. Splitting assumes that occurrences are distributed uniformly within
+// the span, so quantities of occurrences of items are estimates.
+//
+// Increase takes time proportional to the limit of the quantity of items.
+type Adaptive[Type constraints.Integer] struct {
+	err      error
+	items    []Item[Type]
+	limit    int
+	overflow Overflow
+	total    uint64
+}
+
+// Creates an adaptive statistics with the specified maximum quantity of items.
+func NewAdaptive[Type constraints.Integer](limit int) (*Adaptive[Type], error) {
+	if limit < 0 {
+		return nil, ErrItemsQuantityNegative
+	}
+
+	if limit == 0 {
+		return nil, ErrItemsQuantityZero
+	}
+
+	adp := &Adaptive[Type]{
+		items: make([]Item[Type], 0, limit+1),
+		limit: limit,
+	}
+
+	return adp, nil
+}
+
+// Increases the quantity of occurrences of the specified value.
+//
+// On overflow of the quantity of occurrences it behaves as specified by
+// [Adaptive.SetOverflow], in the [OverflowError] mode the error is available via
+// [Adaptive.Err].
+func (adp *Adaptive[Type]) Inc(value Type) {
+	_ = adp.Add(value, 1)
+}
+
+// Increases the quantity of occurrences of the specified value by the specified
+// quantity.
+//
+// Error is returned only in the [OverflowError] mode on overflow of the quantity
+// of occurrences.
+func (adp *Adaptive[Type]) Add(value Type, quantity uint64) error {
+	if quantity == 0 {
+		return nil
+	}
+
+	target := Item[Type]{
+		Span: span.Span[Type]{Begin: value, End: value},
+	}
+
+	id, found := slices.BinarySearchFunc(adp.items, target, search)
+	if !found {
+		item := Item[Type]{
+			Kind:     ItemKindRegular,
+			Quantity: quantity,
+			Span:     target.Span,
+		}
+
+		adp.total = addSat(adp.total, quantity)
+		adp.items = slices.Insert(adp.items, id, item)
+
+		return adp.shrink()
+	}
+
+	if err := addQuantity(&adp.items[id].Quantity, quantity, adp.overflow); err != nil {
+		adp.err = err
+		return err
+	}
+
+	adp.total = addSat(adp.total, quantity)
+
+	if adp.isHeavy(adp.items[id]) {
+		adp.split(id)
+		return adp.shrink()
+	}
+
+	return nil
+}
+
+// Sets the behavior of statistics on overflow of the quantity of occurrences.
+//
+// Items are merged regardless of the overflow, so in the [OverflowError] mode the
+// quantity of occurrences of merged items is limited by the maximum value of
+// uint64 and the error is recorded.
+func (adp *Adaptive[Type]) SetOverflow(overflow Overflow) {
+	adp.overflow = overflow
+}
+
+// Returns the [ErrQuantityOverflow] error if an overflow of the quantity of
+// occurrences has occurred in the [OverflowError] mode, otherwise returns nil.
+func (adp *Adaptive[Type]) Err() error {
+	return adp.err
+}
+
+// Returns true if the item has more than twice the mean quantity of occurrences
+// and can be split.
+func (adp *Adaptive[Type]) isHeavy(item Item[Type]) bool {
+	if item.Span.Begin == item.Span.End {
+		return false
+	}
+
+	mean := adp.total / uint64(adp.limit)
+
+	return item.Quantity > 2*mean
+}
+
+// Splits the item with the specified index in half.
+func (adp *Adaptive[Type]) split(id int) {
+	item := adp.items[id]
+
+	half := safe.Dist(item.Span.End, item.Span.Begin) / 2
+	middle := item.Span.Begin + Type(half)
+
+	left := Item[Type]{
+		Kind:     ItemKindRegular,
+		Quantity: item.Quantity - item.Quantity/2,
+		Span:     span.Span[Type]{Begin: item.Span.Begin, End: middle},
+	}
+
+	right := Item[Type]{
+		Kind:     ItemKindRegular,
+		Quantity: item.Quantity / 2,
+		Span:     span.Span[Type]{Begin: middle + 1, End: item.Span.End},
+	}
+
+	adp.items[id] = left
+	adp.items = slices.Insert(adp.items, id+1, right)
+}
+
+// Merges adjacent pairs of items with the smallest total quantity of occurrences
+// until the quantity of items does not exceed the limit.
+func (adp *Adaptive[Type]) shrink() error {
+	var failure error
+
+	for len(adp.items) > adp.limit {
+		lightest := 0
+
+		for id := 1; id < len(adp.items)-1; id++ {
+			if adp.pairQuantity(id) < adp.pairQuantity(lightest) {
+				lightest = id
+			}
+		}
+
+		merged := &adp.items[lightest]
+
+		if err := addQuantity(&merged.Quantity, adp.items[lightest+1].Quantity, adp.overflow); err != nil {
+			merged.Quantity = math.MaxUint64
+			adp.err = err
+			failure = err
+		}
+
+		merged.Span.End = adp.items[lightest+1].Span.End
+		adp.items = slices.Delete(adp.items, lightest+1, lightest+2)
+	}
+
+	return failure
+}
+
+func (adp *Adaptive[Type]) pairQuantity(id int) uint64 {
+	return addSat(adp.items[id].Quantity, adp.items[id+1].Quantity)
+}
+
+// Returns a list of statistics items.
+//
+// All items are regular, values between spans of items have not occurred.
+func (adp *Adaptive[Type]) Items() []Item[Type] {
+	return slices.Clone(adp.items)
+}
+
+// Returns statistics with the current spans and quantities of occurrences.
+//
+// Returned statistics is a copy and is not changed by subsequent increases. Error
+// is returned if no values have occurred.
+func (adp *Adaptive[Type]) Snapshot() (*Stat[Type], error) {
+	return restore(adp.items)
+}
+
+// Writes statistics as a bar chart to the specified writer.
+//
+// If no values have occurred, the bar chart is empty. See [Stat.GraphWith] for
+// details.
+func (adp *Adaptive[Type]) GraphWith(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(adp.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return graph(writer, chr)
+}
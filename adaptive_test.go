@@ -0,0 +1,191 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func requireAdaptiveValid[Type int | uint8](t *testing.T, adp *Adaptive[Type], total uint64) {
+	t.Helper()
+
+	items := adp.Items()
+
+	require.LessOrEqual(t, len(items), adp.limit)
+
+	sum := uint64(0)
+
+	for id, item := range items {
+		require.Equal(t, ItemKindRegular, item.Kind)
+		require.LessOrEqual(t, item.Span.Begin, item.Span.End)
+
+		if id != 0 {
+			require.Less(t, items[id-1].Span.End, item.Span.Begin)
+		}
+
+		sum += item.Quantity
+	}
+
+	require.Equal(t, total, sum)
+}
+
+func TestAdaptive(t *testing.T) {
+	adp, err := NewAdaptive[int](3)
+	require.NoError(t, err)
+
+	adp.Inc(3)
+	adp.Inc(1)
+	adp.Inc(2)
+
+	expected := []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 1, End: 1}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 2, End: 2}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 3, End: 3}},
+	}
+
+	require.Equal(t, expected, adp.Items())
+
+	adp.Inc(10)
+	adp.Inc(10)
+
+	expected = []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 1, End: 2}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 3, End: 3}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 10, End: 10}},
+	}
+
+	require.Equal(t, expected, adp.Items())
+
+	for range 4 {
+		adp.Inc(2)
+	}
+
+	expected = []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 3, Span: span.Span[int]{Begin: 1, End: 1}},
+		{Kind: ItemKindRegular, Quantity: 4, Span: span.Span[int]{Begin: 2, End: 3}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 10, End: 10}},
+	}
+
+	require.Equal(t, expected, adp.Items())
+	requireAdaptiveValid(t, adp, 9)
+}
+
+func TestAdaptiveEqualMass(t *testing.T) {
+	const (
+		limit    = 10
+		quantity = 100000
+	)
+
+	adp, err := NewAdaptive[int](limit)
+	require.NoError(t, err)
+
+	//nolint:gosec // Deterministic sequence is required
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range quantity {
+		adp.Inc(int(random.ExpFloat64() * 1000))
+	}
+
+	requireAdaptiveValid(t, adp, quantity)
+
+	items := adp.Items()
+	require.Len(t, items, limit)
+
+	for _, item := range items {
+		require.Less(t, item.Quantity, uint64(3*quantity/limit), item)
+	}
+
+	widths := make([]uint64, 0, len(items))
+
+	for _, item := range items {
+		widths = append(widths, uint64(item.Span.End-item.Span.Begin+1))
+	}
+
+	// Spans widen along with the decreasing density of the distribution
+	require.Less(t, widths[0], widths[len(widths)/2])
+	require.Less(t, widths[len(widths)/2], widths[len(widths)-1])
+}
+
+func TestAdaptiveFullRange(t *testing.T) {
+	adp, err := NewAdaptive[uint8](4)
+	require.NoError(t, err)
+
+	for range 10 {
+		for value := range 256 {
+			adp.Inc(uint8(value))
+		}
+	}
+
+	requireAdaptiveValid(t, adp, 2560)
+}
+
+func TestAdaptiveSnapshot(t *testing.T) {
+	adp, err := NewAdaptive[int](5)
+	require.NoError(t, err)
+
+	_, err = adp.Snapshot()
+	require.ErrorIs(t, err, ErrSpansListEmpty)
+
+	for value := range 100 {
+		adp.Inc(value)
+	}
+
+	snapshot, err := adp.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, adp.Items(), snapshot.Items())
+
+	adp.Inc(1000)
+	require.NotEqual(t, adp.Items(), snapshot.Items())
+
+	require.NoError(t, adp.GraphWith(io.Discard))
+}
+
+func TestAdaptiveGraphEmpty(t *testing.T) {
+	adp, err := NewAdaptive[int](5)
+	require.NoError(t, err)
+
+	require.NoError(t, adp.GraphWith(io.Discard))
+	require.NoError(t, adp.GraphWith(io.Discard, WithPercentage(), WithLogarithmic()))
+	require.Error(t, adp.GraphWith(io.Discard, WithTop(-1)))
+}
+
+func TestAdaptiveError(t *testing.T) {
+	_, err := NewAdaptive[int](-1)
+	require.ErrorIs(t, err, ErrItemsQuantityNegative)
+
+	_, err = NewAdaptive[int](0)
+	require.ErrorIs(t, err, ErrItemsQuantityZero)
+}
+
+func TestAdaptiveOverflow(t *testing.T) {
+	adp, err := NewAdaptive[int](1)
+	require.NoError(t, err)
+
+	require.NoError(t, adp.Add(1, math.MaxUint64))
+	adp.Inc(1)
+	require.NoError(t, adp.Err())
+	require.Zero(t, adp.items[0].Quantity)
+
+	adp.SetOverflow(OverflowSaturate)
+
+	require.NoError(t, adp.Add(1, math.MaxUint64))
+	require.NoError(t, adp.Add(2, 1))
+	require.Equal(t, uint64(math.MaxUint64), adp.items[0].Quantity)
+
+	adp.SetOverflow(OverflowError)
+
+	require.ErrorIs(t, adp.Add(2, 1), ErrQuantityOverflow)
+	require.ErrorIs(t, adp.Err(), ErrQuantityOverflow)
+
+	expected := []Item[int]{
+		{Kind: ItemKindRegular, Quantity: math.MaxUint64, Span: span.Span[int]{Begin: 1, End: 2}},
+	}
+
+	require.Equal(t, expected, adp.Items())
+	require.ErrorIs(t, adp.Add(3, 1), ErrQuantityOverflow)
+	require.NoError(t, adp.Add(3, 0))
+}
@@ -0,0 +1,331 @@
+package stat
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/akramarenkov/safe"
+	"golang.org/x/exp/constraints"
+)
+
+// Kind of the layout of statistics spans.
+type LayoutKind int
+
+const (
+	LayoutKindLinear LayoutKind = iota + 1
+	LayoutKindExponential
+)
+
+func (lk LayoutKind) String() string {
+	switch lk {
+	case LayoutKindLinear:
+		return "linear"
+	case LayoutKindExponential:
+		return "exponential"
+	}
+
+	return "unexpected"
+}
+
+// Layout of statistics spans.
+type Layout[Type constraints.Integer] struct {
+	// Kind of layout
+	Kind LayoutKind
+
+	// Beginning of the first span
+	Lower Type
+
+	// End of the last span
+	Upper Type
+
+	// Quantity of items of the linear layout
+	Quantity Type
+
+	// Factor of the exponential layout
+	Factor Type
+}
+
+func (lt Layout[Type]) String() string {
+	if lt.Kind == LayoutKindExponential {
+		return fmt.Sprintf("%v [%v:%v] by factor %v", lt.Kind, lt.Lower, lt.Upper, lt.Factor)
+	}
+
+	return fmt.Sprintf("%v [%v:%v] by quantity %v", lt.Kind, lt.Lower, lt.Upper, lt.Quantity)
+}
+
+// Creates statistics with the layout.
+func (lt Layout[Type]) create() (*Stat[Type], error) {
+	if lt.Kind == LayoutKindExponential {
+		return NewExponential(lt.Lower, lt.Upper, lt.Factor)
+	}
+
+	return NewLinearQ(lt.Lower, lt.Upper, lt.Quantity)
+}
+
+// Option of the auto-ranging statistics.
+type AutoOption func(opts *autoOpts)
+
+type autoOpts struct {
+	exponential   bool
+	factor        int
+	lowerQuantile float64
+	quantity      int
+	upperQuantile float64
+}
+
+// Sets the quantity of items of the linear layout.
+//
+// Quantity is reduced if it exceeds the quantity of values between the chosen
+// bounds. By default, it is equal to 20.
+func WithAutoQuantity(quantity int) AutoOption {
+	return func(opts *autoOpts) {
+		opts.quantity = quantity
+	}
+}
+
+// Enables the exponential layout with the specified factor instead of the
+// linear one.
+//
+// Exponential layout is suitable only for positive values, so the lower bound is
+// chosen not less than one.
+func WithAutoExponential(factor int) AutoOption {
+	return func(opts *autoOpts) {
+		opts.exponential = true
+		opts.factor = factor
+	}
+}
+
+// Sets the quantiles of the warm-up sample used as bounds of the layout.
+//
+// Values of the warm-up sample beyond the bounds are counted in the -Inf and
+// +Inf items. By default, bounds are the minimum and maximum values of the
+// warm-up sample (quantiles 0 and 1).
+func WithAutoQuantiles(lower, upper float64) AutoOption {
+	return func(opts *autoOpts) {
+		opts.lowerQuantile = lower
+		opts.upperQuantile = upper
+	}
+}
+
+func newAutoOpts(options []AutoOption) (autoOpts, error) {
+	opts := autoOpts{
+		factor:        autoDefaultFactor,
+		quantity:      autoDefaultQuantity,
+		upperQuantile: 1,
+	}
+
+	for _, option := range options {
+		option(&opts)
+	}
+
+	if opts.quantity < 0 {
+		return autoOpts{}, ErrItemsQuantityNegative
+	}
+
+	if opts.quantity == 0 {
+		return autoOpts{}, ErrItemsQuantityZero
+	}
+
+	if opts.factor < 2 {
+		return autoOpts{}, ErrFactorTooSmall
+	}
+
+	if !isQuantileValid(opts.lowerQuantile) || !isQuantileValid(opts.upperQuantile) {
+		return autoOpts{}, ErrQuantileInvalid
+	}
+
+	if opts.lowerQuantile > opts.upperQuantile {
+		return autoOpts{}, ErrLowerGreaterUpper
+	}
+
+	return opts, nil
+}
+
+// Auto-ranging statistics that chooses its layout from a warm-up sample.
+//
+// The first values are buffered until the warm-up sample is collected, then the
+// layout is chosen from the bounds of the sample, the buffered values are
+// replayed into statistics with this layout and subsequent values are counted
+// in it directly.
+//
+// If the layout cannot be created from the warm-up sample, the error is kept,
+// the buffered values are discarded and subsequent values are not counted.
+type Auto[Type constraints.Integer] struct {
+	buffer []Type
+	err    error
+	layout Layout[Type]
+	opts   autoOpts
+	stat   *Stat[Type]
+	warmup int
+}
+
+// Creates an auto-ranging statistics with the specified size of the warm-up
+// sample.
+func NewAuto[Type constraints.Integer](warmup int, opts ...AutoOption) (*Auto[Type], error) {
+	if warmup <= 0 {
+		return nil, ErrWarmupNotPositive
+	}
+
+	options, err := newAutoOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	at := &Auto[Type]{
+		buffer: make([]Type, 0, warmup),
+		opts:   options,
+		warmup: warmup,
+	}
+
+	return at, nil
+}
+
+// Increases the quantity of occurrences of the specified value.
+//
+// Error is returned only if the layout cannot be created when the warm-up sample
+// is collected, in this case the same error is returned by all subsequent
+// increases, see [Auto.Err].
+func (at *Auto[Type]) Inc(value Type) error {
+	if at.stat != nil {
+		at.stat.Inc(value)
+		return nil
+	}
+
+	if at.err != nil {
+		return at.err
+	}
+
+	at.buffer = append(at.buffer, value)
+
+	if len(at.buffer) < at.warmup {
+		return nil
+	}
+
+	return at.Settle()
+}
+
+// Chooses the layout from the values buffered so far without waiting for the
+// warm-up sample to be collected.
+//
+// Does nothing if the layout has already been chosen. Returns the
+// [ErrNoOccurrences] error if no values have been buffered and the error kept
+// by [Auto.Err] if the layout cannot be created.
+func (at *Auto[Type]) Settle() error {
+	if at.stat != nil {
+		return nil
+	}
+
+	if at.err != nil {
+		return at.err
+	}
+
+	if len(at.buffer) == 0 {
+		return ErrNoOccurrences
+	}
+
+	st, layout, err := at.build()
+	if err != nil {
+		at.buffer = nil
+		at.err = err
+
+		return err
+	}
+
+	for _, value := range at.buffer {
+		st.Inc(value)
+	}
+
+	at.buffer = nil
+	at.layout = layout
+	at.stat = st
+
+	return nil
+}
+
+// Chooses the layout from the buffered values and creates statistics with it.
+func (at *Auto[Type]) build() (*Stat[Type], Layout[Type], error) {
+	layout, err := at.choose()
+	if err != nil {
+		return nil, Layout[Type]{}, err
+	}
+
+	st, err := layout.create()
+	if err != nil {
+		return nil, Layout[Type]{}, err
+	}
+
+	return st, layout, nil
+}
+
+// Returns the error that occurred when creating the layout from the warm-up
+// sample or nil if it has not occurred.
+func (at *Auto[Type]) Err() error {
+	return at.err
+}
+
+func (at *Auto[Type]) choose() (Layout[Type], error) {
+	sample := slices.Sorted(slices.Values(at.buffer))
+
+	lower := sample[sampleRank(len(sample), at.opts.lowerQuantile)]
+	upper := sample[sampleRank(len(sample), at.opts.upperQuantile)]
+
+	if at.opts.exponential {
+		factor, err := safe.IToI[Type](at.opts.factor)
+		if err != nil {
+			return Layout[Type]{}, err
+		}
+
+		lower = max(lower, 1)
+		upper = max(upper, lower)
+
+		layout := Layout[Type]{
+			Kind:   LayoutKindExponential,
+			Lower:  lower,
+			Upper:  upper,
+			Factor: factor,
+		}
+
+		return layout, nil
+	}
+
+	// Quantity of items cannot exceed the quantity of values between the bounds
+	quantity := uint64(at.opts.quantity)
+
+	if distance := safe.Dist(upper, lower); distance < quantity-1 {
+		quantity = distance + 1
+	}
+
+	converted, err := safe.IToI[Type](quantity)
+	if err != nil {
+		return Layout[Type]{}, err
+	}
+
+	layout := Layout[Type]{
+		Kind:     LayoutKindLinear,
+		Lower:    lower,
+		Upper:    upper,
+		Quantity: converted,
+	}
+
+	return layout, nil
+}
+
+// Returns the index of the value of the specified quantile in a sorted sample of
+// the specified size.
+func sampleRank(size int, quantile float64) int {
+	return int(quantile * float64(size-1))
+}
+
+// Returns the chosen layout and true or zero layout and false if the layout has
+// not been chosen yet.
+func (at *Auto[Type]) Layout() (Layout[Type], bool) {
+	return at.layout, at.stat != nil
+}
+
+// Returns statistics with the chosen layout or nil if the layout has not been
+// chosen yet.
+//
+// Returned statistics is not a copy and is changed by subsequent increases.
+func (at *Auto[Type]) Stat() *Stat[Type] {
+	return at.stat
+}
@@ -0,0 +1,159 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuto(t *testing.T) {
+	at, err := NewAuto[int](5, WithAutoQuantity(4))
+	require.NoError(t, err)
+
+	for _, value := range []int{40, 1, 20, 1, 39} {
+		_, settled := at.Layout()
+		require.False(t, settled)
+		require.Nil(t, at.Stat())
+
+		require.NoError(t, at.Inc(value))
+	}
+
+	layout, settled := at.Layout()
+	require.True(t, settled)
+	require.Equal(
+		t,
+		Layout[int]{Kind: LayoutKindLinear, Lower: 1, Upper: 40, Quantity: 4},
+		layout,
+	)
+	require.Equal(t, "linear [1:40] by quantity 4", layout.String())
+
+	require.NoError(t, at.Inc(0))
+	require.NoError(t, at.Inc(15))
+
+	expected := []Item[int]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 21, End: 30}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 31, End: 40}},
+	}
+
+	require.Equal(t, expected, at.Stat().Items())
+	require.NoError(t, at.Settle())
+}
+
+func TestAutoExponential(t *testing.T) {
+	at, err := NewAuto[uint16](100, WithAutoExponential(10))
+	require.NoError(t, err)
+
+	require.NoError(t, at.Inc(0))
+	require.NoError(t, at.Inc(5))
+	require.NoError(t, at.Inc(500))
+	require.NoError(t, at.Settle())
+
+	layout, settled := at.Layout()
+	require.True(t, settled)
+	require.Equal(
+		t,
+		Layout[uint16]{Kind: LayoutKindExponential, Lower: 1, Upper: 500, Factor: 10},
+		layout,
+	)
+	require.Equal(t, "exponential [1:500] by factor 10", layout.String())
+
+	summary := at.Stat().Summary()
+	require.Equal(t, uint64(3), summary.Total)
+	require.Equal(t, uint64(1), summary.NegInf)
+}
+
+func TestAutoQuantiles(t *testing.T) {
+	at, err := NewAuto[int](
+		101,
+		WithAutoQuantity(1000),
+		WithAutoQuantiles(0.1, 0.9),
+	)
+	require.NoError(t, err)
+
+	for value := range 101 {
+		require.NoError(t, at.Inc(value))
+	}
+
+	layout, settled := at.Layout()
+	require.True(t, settled)
+	require.Equal(
+		t,
+		Layout[int]{Kind: LayoutKindLinear, Lower: 10, Upper: 90, Quantity: 81},
+		layout,
+	)
+
+	summary := at.Stat().Summary()
+	require.Equal(t, uint64(10), summary.NegInf)
+	require.Equal(t, uint64(10), summary.PosInf)
+}
+
+func TestAutoSingleValue(t *testing.T) {
+	at, err := NewAuto[int8](2)
+	require.NoError(t, err)
+
+	require.NoError(t, at.Inc(-128))
+	require.NoError(t, at.Inc(-128))
+
+	layout, settled := at.Layout()
+	require.True(t, settled)
+	require.Equal(
+		t,
+		Layout[int8]{Kind: LayoutKindLinear, Lower: -128, Upper: -128, Quantity: 1},
+		layout,
+	)
+}
+
+func TestAutoError(t *testing.T) {
+	_, err := NewAuto[int](0)
+	require.ErrorIs(t, err, ErrWarmupNotPositive)
+
+	_, err = NewAuto[int](1, WithAutoQuantity(-1))
+	require.ErrorIs(t, err, ErrItemsQuantityNegative)
+
+	_, err = NewAuto[int](1, WithAutoQuantity(0))
+	require.ErrorIs(t, err, ErrItemsQuantityZero)
+
+	_, err = NewAuto[int](1, WithAutoExponential(1))
+	require.ErrorIs(t, err, ErrFactorTooSmall)
+
+	_, err = NewAuto[int](1, WithAutoQuantiles(-0.1, 1))
+	require.ErrorIs(t, err, ErrQuantileInvalid)
+
+	_, err = NewAuto[int](1, WithAutoQuantiles(0.9, 0.1))
+	require.ErrorIs(t, err, ErrLowerGreaterUpper)
+
+	at, err := NewAuto[int](1)
+	require.NoError(t, err)
+	require.ErrorIs(t, at.Settle(), ErrNoOccurrences)
+
+	narrow, err := NewAuto[int8](2, WithAutoExponential(1000))
+	require.NoError(t, err)
+
+	require.NoError(t, narrow.Inc(1))
+	require.NoError(t, narrow.Err())
+
+	err = narrow.Inc(100)
+	require.Error(t, err)
+	require.Nil(t, narrow.Stat())
+	require.Nil(t, narrow.buffer)
+
+	for value := range int8(10) {
+		require.Equal(t, err, narrow.Inc(value))
+	}
+
+	require.Equal(t, err, narrow.Settle())
+	require.Equal(t, err, narrow.Err())
+	require.Nil(t, narrow.buffer)
+	require.Nil(t, narrow.Stat())
+}
+
+func TestLayoutKindString(t *testing.T) {
+	require.Equal(t, "linear", LayoutKindLinear.String())
+	require.Equal(t, "exponential", LayoutKindExponential.String())
+	require.Equal(t, "unexpected", LayoutKind(0).String())
+}
@@ -0,0 +1,164 @@
+package stat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Encodes statistics in a compact binary format.
+//
+// Format contains the type of values, so statistics can only be decoded into
+// statistics with the same type of values.
+func (st *Stat[Type]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(binaryMagic)+binaryHeaderSize+len(st.items)*binary.MaxVarintLen64)
+
+	data = append(data, binaryMagic...)
+	data = append(data, binaryVersion, binaryType[Type]())
+	data = binary.AppendUvarint(data, uint64(len(st.items)))
+
+	for _, item := range st.items {
+		data = appendValue(data, item.Span.Begin)
+		data = appendValue(data, item.Span.End)
+		data = binary.AppendUvarint(data, item.Quantity)
+	}
+
+	data = binary.AppendUvarint(data, st.negInf.Quantity)
+	data = binary.AppendUvarint(data, st.posInf.Quantity)
+	data = binary.AppendUvarint(data, st.missed.Quantity)
+
+	return data, nil
+}
+
+// Restores statistics encoded by [Stat.MarshalBinary].
+//
+// Restored statistics does not have a prediction function.
+func (st *Stat[Type]) UnmarshalBinary(data []byte) error {
+	header, found := bytes.CutPrefix(data, []byte(binaryMagic))
+	if !found || len(header) < binaryHeaderSize {
+		return ErrDataInvalid
+	}
+
+	if header[0] != binaryVersion {
+		return ErrDataInvalid
+	}
+
+	if header[1] != binaryType[Type]() {
+		return ErrTypeMismatch
+	}
+
+	reader := bytes.NewReader(header[binaryHeaderSize:])
+
+	quantity, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return ErrDataInvalid
+	}
+
+	// Each item occupies at least three bytes
+	if quantity > uint64(reader.Len())/binaryItemMinSize {
+		return ErrDataInvalid
+	}
+
+	items := make([]Item[Type], 0, quantity+specialItemsQuantity)
+
+	for range quantity {
+		item := Item[Type]{Kind: ItemKindRegular}
+
+		if item.Span.Begin, err = readValue[Type](reader); err != nil {
+			return err
+		}
+
+		if item.Span.End, err = readValue[Type](reader); err != nil {
+			return err
+		}
+
+		if item.Quantity, err = binary.ReadUvarint(reader); err != nil {
+			return ErrDataInvalid
+		}
+
+		items = append(items, item)
+	}
+
+	for _, kind := range []ItemKind{ItemKindNegInf, ItemKindPosInf, ItemKindMissed} {
+		count, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return ErrDataInvalid
+		}
+
+		items = append(items, Item[Type]{Kind: kind, Quantity: count})
+	}
+
+	if reader.Len() != 0 {
+		return ErrDataInvalid
+	}
+
+	restored, err := restore(items)
+	if err != nil {
+		return err
+	}
+
+	*st = *restored
+
+	return nil
+}
+
+// Returns the code of type of values consisting of the quantity of bits and the
+// sign flag.
+func binaryType[Type constraints.Integer]() byte {
+	_, maximum := intspec.Range[Type]()
+
+	length := bits.Len64(uint64(maximum))
+
+	if isSigned[Type]() {
+		return byte(length+1) | binarySignedFlag
+	}
+
+	return byte(length)
+}
+
+func isSigned[Type constraints.Integer]() bool {
+	minimum, _ := intspec.Range[Type]()
+
+	return minimum < 0
+}
+
+func appendValue[Type constraints.Integer](data []byte, value Type) []byte {
+	if isSigned[Type]() {
+		return binary.AppendVarint(data, int64(value))
+	}
+
+	return binary.AppendUvarint(data, uint64(value))
+}
+
+func readValue[Type constraints.Integer](reader *bytes.Reader) (Type, error) {
+	if isSigned[Type]() {
+		value, err := binary.ReadVarint(reader)
+		if err != nil {
+			return 0, ErrDataInvalid
+		}
+
+		converted := Type(value)
+
+		if int64(converted) != value {
+			return 0, ErrDataInvalid
+		}
+
+		return converted, nil
+	}
+
+	value, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return 0, ErrDataInvalid
+	}
+
+	converted := Type(value)
+
+	if uint64(converted) != value {
+		return 0, ErrDataInvalid
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,105 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatMarshalBinary(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	stat.Inc(100)
+	stat.missed.Quantity = 2
+
+	data, err := stat.MarshalBinary()
+	require.NoError(t, err)
+
+	restored := new(Stat[int])
+
+	require.NoError(t, restored.UnmarshalBinary(data))
+	require.Equal(t, stat.Items(), restored.Items())
+
+	unsigned, err := NewLinear[uint64](0, math.MaxUint64, math.MaxUint64/4)
+	require.NoError(t, err)
+
+	unsigned.Inc(math.MaxUint64)
+
+	data, err = unsigned.MarshalBinary()
+	require.NoError(t, err)
+
+	restoredUnsigned := new(Stat[uint64])
+
+	require.NoError(t, restoredUnsigned.UnmarshalBinary(data))
+	require.Equal(t, unsigned.Items(), restoredUnsigned.Items())
+}
+
+func TestStatUnmarshalBinaryError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	data, err := stat.MarshalBinary()
+	require.NoError(t, err)
+
+	restored := new(Stat[int])
+
+	require.ErrorIs(t, restored.UnmarshalBinary(nil), ErrDataInvalid)
+	require.ErrorIs(t, restored.UnmarshalBinary(data[:len(binaryMagic)+1]), ErrDataInvalid)
+	require.ErrorIs(t, new(Stat[int8]).UnmarshalBinary(data), ErrTypeMismatch)
+	require.ErrorIs(t, new(Stat[uint]).UnmarshalBinary(data), ErrTypeMismatch)
+
+	for length := len(binaryMagic) + binaryHeaderSize; length < len(data); length++ {
+		require.ErrorIs(t, restored.UnmarshalBinary(data[:length]), ErrDataInvalid, length)
+	}
+
+	require.ErrorIs(t, restored.UnmarshalBinary(append(data, 0)), ErrDataInvalid)
+
+	version := append([]byte(nil), data...)
+	version[len(binaryMagic)] = binaryVersion + 1
+
+	require.ErrorIs(t, restored.UnmarshalBinary(version), ErrDataInvalid)
+
+	wide, err := NewLinear(1000, 2000, 500)
+	require.NoError(t, err)
+
+	data, err = wide.MarshalBinary()
+	require.NoError(t, err)
+
+	data[len(binaryMagic)+1] = binaryType[int16]()
+
+	require.NoError(t, new(Stat[int16]).UnmarshalBinary(data))
+
+	data, err = wide.MarshalBinary()
+	require.NoError(t, err)
+
+	data[len(binaryMagic)+1] = binaryType[int8]()
+
+	require.ErrorIs(t, new(Stat[int8]).UnmarshalBinary(data), ErrDataInvalid)
+
+	unsigned, err := NewLinear[uint](1000, 2000, 500)
+	require.NoError(t, err)
+
+	data, err = unsigned.MarshalBinary()
+	require.NoError(t, err)
+
+	data[len(binaryMagic)+1] = binaryType[uint8]()
+
+	require.ErrorIs(t, new(Stat[uint8]).UnmarshalBinary(data), ErrDataInvalid)
+
+	unsorted := []byte(binaryMagic)
+	unsorted = append(unsorted, binaryVersion, binaryType[uint8](), 2, 5, 6, 0, 1, 2, 0, 0, 0, 0)
+
+	require.Error(t, new(Stat[uint8]).UnmarshalBinary(unsorted))
+
+	overstated := []byte(binaryMagic)
+	overstated = append(overstated, binaryVersion, binaryType[uint8](), 2, 1, 2, 0, 0, 0)
+
+	require.ErrorIs(t, new(Stat[uint8]).UnmarshalBinary(overstated), ErrDataInvalid)
+}
+
+func TestBinaryType(t *testing.T) {
+	require.Equal(t, byte(8), binaryType[uint8]())
+	require.Equal(t, byte(8|binarySignedFlag), binaryType[int8]())
+	require.Equal(t, byte(64), binaryType[uint64]())
+	require.Equal(t, byte(64|binarySignedFlag), binaryType[int64]())
+}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/stat"
+)
+
+type diffConfig struct {
+	alpha       float64
+	ascii       bool
+	collapse    bool
+	log         bool
+	maxIncrease float64
+	quantile    float64
+}
+
+func runDiff(args []string, stdout io.Writer, stderr io.Writer) int {
+	cfg := diffConfig{}
+
+	flags := flag.NewFlagSet("stat diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	flags.Float64Var(&cfg.quantile, "quantile", 0.99, "quantile to compare")
+	flags.Float64Var(
+		&cfg.maxIncrease,
+		"max-increase",
+		-1,
+		"maximum allowed increase of the quantile in percent, negative value disables the check",
+	)
+	flags.Float64Var(
+		&cfg.alpha,
+		"alpha",
+		0,
+		"minimum allowed p-value of the Kolmogorov-Smirnov test, zero disables the check",
+	)
+	flags.BoolVar(&cfg.log, "log", false, "logarithmic scale of bars")
+	flags.BoolVar(&cfg.collapse, "collapse", false, "collapse runs of empty items")
+	flags.BoolVar(&cfg.ascii, "ascii", false, "use only ASCII characters")
+
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: stat diff [flags] baseline candidate")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if flags.NArg() != 2 { //nolint:mnd // Baseline and candidate
+		flags.Usage()
+		return exitUsage
+	}
+
+	exceeded, err := diff(cfg, flags.Arg(0), flags.Arg(1), stdout)
+	if err != nil {
+		fmt.Fprintln(stderr, "stat:", err)
+		return exitFailure
+	}
+
+	if exceeded {
+		return exitThreshold
+	}
+
+	return 0
+}
+
+func diff(cfg diffConfig, baselinePath string, candidatePath string, stdout io.Writer) (bool, error) {
+	baseline, err := load(baselinePath)
+	if err != nil {
+		return false, err
+	}
+
+	candidate, err := load(candidatePath)
+	if err != nil {
+		return false, err
+	}
+
+	if !isLayoutsEqual(baseline, candidate) {
+		return false, stat.ErrLayoutsMismatch
+	}
+
+	opts := make([]stat.GraphOption, 0)
+
+	if cfg.ascii {
+		opts = append(opts, stat.WithASCII())
+	}
+
+	if cfg.collapse {
+		opts = append(opts, stat.WithCollapse())
+	}
+
+	if cfg.log {
+		opts = append(opts, stat.WithLogarithmic())
+	}
+
+	if err := stat.Compare(baseline, candidate).Text(stdout, opts...); err != nil {
+		return false, err
+	}
+
+	builder := new(strings.Builder)
+
+	builder.WriteString("\n")
+
+	chi, chiErr := stat.ChiSquare(baseline, candidate)
+	ks, ksErr := stat.KolmogorovSmirnov(baseline, candidate)
+	emd, emdErr := stat.EarthMoversDistance(baseline, candidate)
+
+	writeTest(builder, "Chi-square", chi, chiErr)
+	writeTest(builder, "Kolmogorov-Smirnov", ks, ksErr)
+
+	if emdErr != nil {
+		fmt.Fprintf(builder, "Earth Mover's Distance: %v\n", emdErr)
+	} else {
+		fmt.Fprintf(builder, "Earth Mover's Distance: %s\n", formatFloat(emd))
+	}
+
+	exceeded := false
+
+	if cfg.alpha > 0 && ksErr == nil && ks.PValue < cfg.alpha {
+		fmt.Fprintf(builder, "Kolmogorov-Smirnov p-value is less than %s\n", formatFloat(cfg.alpha))
+
+		exceeded = true
+	}
+
+	quantileExceeded, err := compareQuantile(builder, cfg, baseline, candidate)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := io.WriteString(stdout, builder.String()); err != nil {
+		return false, err
+	}
+
+	return exceeded || quantileExceeded, nil
+}
+
+func compareQuantile(
+	builder *strings.Builder,
+	cfg diffConfig,
+	baseline *stat.Stat[int64],
+	candidate *stat.Stat[int64],
+) (bool, error) {
+	label := quantileLabel(cfg.quantile)
+
+	first, err := baseline.Quantile(cfg.quantile)
+	if err != nil {
+		return false, err
+	}
+
+	second, err := candidate.Quantile(cfg.quantile)
+	if err != nil {
+		return false, err
+	}
+
+	change := relativeChange(first, second)
+
+	fmt.Fprintf(builder, "%s: %d -> %d (%+.2f%%)\n", label, first, second, change)
+
+	if cfg.maxIncrease < 0 || change <= cfg.maxIncrease {
+		return false, nil
+	}
+
+	fmt.Fprintf(builder, "%s increase exceeds %s%%\n", label, formatFloat(cfg.maxIncrease))
+
+	return true, nil
+}
+
+// Returns the change of the value in percent.
+func relativeChange(baseline, candidate int64) float64 {
+	delta := float64(candidate) - float64(baseline)
+
+	if baseline == 0 {
+		switch {
+		case delta > 0:
+			return math.Inf(1)
+		case delta < 0:
+			return math.Inf(-1)
+		}
+
+		return 0
+	}
+
+	return percent * delta / math.Abs(float64(baseline))
+}
+
+func writeTest(builder *strings.Builder, name string, result stat.TestResult, err error) {
+	if err != nil {
+		fmt.Fprintf(builder, "%s: %v\n", name, err)
+		return
+	}
+
+	fmt.Fprintf(
+		builder,
+		"%s: statistic %s, p-value %s\n",
+		name,
+		formatFloat(result.Statistic),
+		formatFloat(result.PValue),
+	)
+}
+
+// Returns the label of the quantile in the form of a percentile, for example p99.
+func quantileLabel(quantile float64) string {
+	return "p" + strconv.FormatFloat(percent*quantile, 'g', percentilePrecision, 64)
+}
+
+func formatFloat(number float64) string {
+	return strconv.FormatFloat(number, 'g', 6, 64)
+}
+
+// Loads statistics saved in JSON or binary format.
+func load(path string) (*stat.Stat[int64], error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := new(stat.Stat[int64])
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		err = loaded.UnmarshalJSON(data)
+	} else {
+		err = loaded.UnmarshalBinary(data)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return loaded, nil
+}
+
+func isLayoutsEqual(baseline, candidate *stat.Stat[int64]) bool {
+	return slices.Equal(regularSpans(baseline), regularSpans(candidate))
+}
+
+func regularSpans(st *stat.Stat[int64]) []stat.Item[int64] {
+	items := make([]stat.Item[int64], 0)
+
+	for _, item := range st.Items() {
+		if item.Kind == stat.ItemKindRegular {
+			item.Quantity = 0
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
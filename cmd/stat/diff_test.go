@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func saveTest(t *testing.T, name string, input string, args ...string) string {
+	t.Helper()
+
+	code, stdout, stderr := runTest(t, input, args...)
+	require.Equal(t, 0, code, stderr)
+
+	path := filepath.Join(t.TempDir(), name)
+
+	require.NoError(t, os.WriteFile(path, []byte(stdout), 0o600))
+
+	return path
+}
+
+func sequenceTest(begin, end int) string {
+	builder := new(strings.Builder)
+
+	for value := begin; value <= end; value++ {
+		builder.WriteString(strconv.Itoa(value))
+		builder.WriteString("\n")
+	}
+
+	return builder.String()
+}
+
+func TestRunDiff(t *testing.T) {
+	layout := []string{"-lower", "1", "-upper", "100", "-width", "10"}
+
+	baseline := saveTest(t, "baseline.json", sequenceTest(1, 100), append(layout, "-output", "json")...)
+	candidate := saveTest(
+		t,
+		"candidate.bin",
+		sequenceTest(1, 100)+sequenceTest(80, 100),
+		append(layout, "-output", "binary")...,
+	)
+
+	code, stdout, stderr := runTest(t, "", "diff", "-quantile", "0.5", "-ascii", "-collapse", "-log", baseline, candidate)
+	require.Equal(t, 0, code, stderr)
+	require.Contains(t, stdout, "[91:100] baseline  |")
+	require.Contains(t, stdout, "candidate |")
+	require.Contains(t, stdout, "20 16.53% +10 +6.53%\n")
+	require.Contains(t, stdout, "Chi-square: statistic 4.76181, p-value 0.854558\n")
+	require.Contains(t, stdout, "Kolmogorov-Smirnov: statistic 0.130579, p-value 0.287757\n")
+	require.Contains(t, stdout, "Earth Mover's Distance: 6.81818\n")
+	require.Contains(t, stdout, "p50: 50 -> 61 (+22.00%)\n")
+
+	code, stdout, _ = runTest(t, "", "diff", "-quantile", "0.5", "-max-increase", "25", baseline, candidate)
+	require.Equal(t, 0, code)
+	require.NotContains(t, stdout, "exceeds")
+
+	code, stdout, _ = runTest(t, "", "diff", "-quantile", "0.5", "-max-increase", "10", baseline, candidate)
+	require.Equal(t, exitThreshold, code)
+	require.Contains(t, stdout, "p50 increase exceeds 10%\n")
+
+	code, stdout, _ = runTest(t, "", "diff", "-alpha", "0.5", baseline, candidate)
+	require.Equal(t, exitThreshold, code)
+	require.Contains(t, stdout, "Kolmogorov-Smirnov p-value is less than 0.5\n")
+}
+
+func TestRunDiffIdentical(t *testing.T) {
+	baseline := saveTest(t, "baseline.json", "0\n", "-lower", "1", "-upper", "10", "-output", "json")
+
+	code, stdout, stderr := runTest(t, "", "diff", baseline, baseline)
+	require.Equal(t, 0, code, stderr)
+	require.Contains(t, stdout, "Chi-square: statistic 0, p-value 1\n")
+	require.Contains(t, stdout, "p99: 0 -> 0 (+0.00%)\n")
+}
+
+func TestRunDiffError(t *testing.T) {
+	baseline := saveTest(t, "baseline.json", "1\n2\n", "-output", "json")
+	other := saveTest(t, "other.json", "1\n3\n", "-output", "json")
+	blank := saveTest(t, "blank.bin", "", "-h")
+
+	broken := filepath.Join(t.TempDir(), "broken.bin")
+	require.NoError(t, os.WriteFile(broken, []byte("broken"), 0o600))
+
+	empty := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(empty, []byte(`{"items":[{"kind":"regular","begin":1,"end":2}]}`), 0o600))
+
+	for _, args := range [][]string{
+		{"diff", baseline},
+		{"diff", "-unknown", baseline, baseline},
+	} {
+		code, _, _ := runTest(t, "", args...)
+		require.Equal(t, exitUsage, code, args)
+	}
+
+	for _, args := range [][]string{
+		{"diff", baseline, filepath.Join(t.TempDir(), "absent.json")},
+		{"diff", filepath.Join(t.TempDir(), "absent.json"), baseline},
+		{"diff", baseline, broken},
+		{"diff", baseline, other},
+		{"diff", baseline, blank},
+		{"diff", "-quantile", "2", baseline, baseline},
+		{"diff", empty, empty},
+	} {
+		code, _, stderr := runTest(t, "", args...)
+		require.Equal(t, exitFailure, code, args)
+		require.NotEmpty(t, stderr, args)
+	}
+
+	code, _, _ := runTest(t, "", "diff", "-h")
+	require.Equal(t, 0, code)
+}
+
+func TestRelativeChange(t *testing.T) {
+	require.InDelta(t, 0, relativeChange(0, 0), 0)
+	require.InDelta(t, 50, relativeChange(-2, -1), 0)
+	require.True(t, relativeChange(0, 1) > 0)
+	require.True(t, relativeChange(0, -1) < 0)
+}
@@ -0,0 +1,369 @@
+// Command stat reads integers from standard input and displays their
+// distribution.
+//
+// Integers are read one per line or, if the -column flag is specified, from the
+// specified column of CSV records. Range of values is detected from the data
+// unless it is specified by the -lower and -upper flags.
+//
+// With the diff subcommand, it compares two statistics saved in JSON or binary
+// format (by the -output json or -output binary flags) and exits with code 3 if
+// the specified thresholds are exceeded.
+//
+// Usage:
+//
+//	stat [flags] < numbers
+//	stat diff [flags] baseline candidate
+//
+// Examples:
+//
+//	awk '{print $NF}' access.log | stat -layout exponential -log
+//	stat -column 3 -header -output quantiles < requests.csv
+//	stat diff -quantile 0.99 -max-increase 10 baseline.json candidate.json
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/akramarenkov/stat"
+)
+
+const (
+	defaultQuantity = 20
+	defaultFactor   = 2
+	exitFailure     = 1
+	exitUsage       = 2
+	exitThreshold   = 3
+)
+
+const (
+	// Ratio of a percentile to a quantile
+	percent = 100
+
+	// Quantity of significant digits of percentiles in labels, it is enough to
+	// hide errors of floating point multiplication
+	percentilePrecision = 10
+)
+
+var (
+	errColumnMissing    = errors.New("column is missing")
+	errColumnNegative   = errors.New("column number is negative")
+	errLayoutUnknown    = errors.New("layout is unknown")
+	errNoValues         = errors.New("there are no values")
+	errOutputUnknown    = errors.New("output is unknown")
+	errSeparatorInvalid = errors.New("separator must be a single character")
+)
+
+type config struct {
+	ascii      bool
+	collapse   bool
+	column     int
+	factor     int64
+	header     bool
+	layout     string
+	log        bool
+	lower      int64
+	lowerSet   bool
+	output     string
+	percentage bool
+	quantiles  string
+	quantity   int64
+	separator  string
+	upper      int64
+	upperSet   bool
+	width      int64
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) != 0 && args[0] == "diff" {
+		return runDiff(args[1:], stdout, stderr)
+	}
+
+	cfg, err := parseFlags(args, stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return exitUsage
+	}
+
+	if err := process(cfg, stdin, stdout); err != nil {
+		fmt.Fprintln(stderr, "stat:", err)
+		return exitFailure
+	}
+
+	return 0
+}
+
+func parseFlags(args []string, stderr io.Writer) (config, error) {
+	cfg := config{}
+
+	flags := flag.NewFlagSet("stat", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	flags.StringVar(&cfg.layout, "layout", "linear", "layout of spans: linear, linearq or exponential")
+	flags.Int64Var(&cfg.lower, "lower", 0, "lower value of spans (default is the minimum value)")
+	flags.Int64Var(&cfg.upper, "upper", 0, "upper value of spans (default is the maximum value)")
+	flags.Int64Var(&cfg.width, "width", 0, "width of spans for linear layout (default is derived from quantity)")
+	flags.Int64Var(&cfg.quantity, "quantity", defaultQuantity, "quantity of spans for linear and linearq layouts")
+	flags.Int64Var(&cfg.factor, "factor", defaultFactor, "factor of span widths for exponential layout")
+	flags.IntVar(&cfg.column, "column", 0, "number of CSV column to read values from, starting from 1")
+	flags.StringVar(&cfg.separator, "separator", ",", "separator of CSV columns")
+	flags.BoolVar(&cfg.header, "header", false, "skip the first CSV record")
+	flags.StringVar(&cfg.output, "output", "graph", "output: graph, text, quantiles, json or binary")
+	flags.StringVar(&cfg.quantiles, "quantiles", "0.5,0.9,0.99,0.999", "comma-separated quantiles to output")
+	flags.BoolVar(&cfg.log, "log", false, "logarithmic scale of bars")
+	flags.BoolVar(&cfg.collapse, "collapse", false, "collapse runs of empty items")
+	flags.BoolVar(&cfg.percentage, "percentage", false, "display percentages")
+	flags.BoolVar(&cfg.ascii, "ascii", false, "use only ASCII characters, applies to text output only")
+
+	if err := flags.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	flags.Visit(func(flg *flag.Flag) {
+		switch flg.Name {
+		case "lower":
+			cfg.lowerSet = true
+		case "upper":
+			cfg.upperSet = true
+		}
+	})
+
+	// Reported like flag parsing errors, so that usage is printed too
+	if cfg.column < 0 {
+		fmt.Fprintln(stderr, "stat:", errColumnNegative)
+		flags.Usage()
+
+		return config{}, errColumnNegative
+	}
+
+	if utf8.RuneCountInString(cfg.separator) != 1 {
+		fmt.Fprintln(stderr, "stat:", errSeparatorInvalid)
+		flags.Usage()
+
+		return config{}, errSeparatorInvalid
+	}
+
+	return cfg, nil
+}
+
+func process(cfg config, stdin io.Reader, stdout io.Writer) error {
+	values, err := readValues(cfg, stdin)
+	if err != nil {
+		return err
+	}
+
+	if len(values) == 0 {
+		return errNoValues
+	}
+
+	st, err := newStat(cfg, values)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		st.Inc(value)
+	}
+
+	return display(cfg, st, stdout)
+}
+
+func readValues(cfg config, stdin io.Reader) ([]int64, error) {
+	if cfg.column > 0 {
+		return readColumn(cfg, stdin)
+	}
+
+	values := make([]int64, 0)
+
+	scanner := bufio.NewScanner(stdin)
+
+	for line := 1; scanner.Scan(); line++ {
+		field := strings.TrimSpace(scanner.Text())
+
+		if field == "" {
+			continue
+		}
+
+		value, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, scanner.Err()
+}
+
+func readColumn(cfg config, stdin io.Reader) ([]int64, error) {
+	reader := csv.NewReader(stdin)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	reader.Comma, _ = utf8.DecodeRuneInString(cfg.separator)
+
+	values := make([]int64, 0)
+
+	for record := 1; ; record++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return values, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if cfg.header && record == 1 {
+			continue
+		}
+
+		if cfg.column > len(fields) {
+			return nil, fmt.Errorf("record %d: %w: %d", record, errColumnMissing, cfg.column)
+		}
+
+		value, err := strconv.ParseInt(strings.TrimSpace(fields[cfg.column-1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("record %d: %w", record, err)
+		}
+
+		values = append(values, value)
+	}
+}
+
+func newStat(cfg config, values []int64) (*stat.Stat[int64], error) {
+	lower, upper := cfg.lower, cfg.upper
+
+	if !cfg.lowerSet {
+		lower = values[0]
+
+		for _, value := range values {
+			lower = min(lower, value)
+		}
+	}
+
+	if !cfg.upperSet {
+		upper = values[0]
+
+		for _, value := range values {
+			upper = max(upper, value)
+		}
+	}
+
+	switch cfg.layout {
+	case "linear":
+		if cfg.width != 0 {
+			return stat.NewLinear(lower, upper, cfg.width)
+		}
+
+		return stat.NewLinearQ(lower, upper, limitQuantity(lower, upper, cfg.quantity))
+	case "linearq":
+		return stat.NewLinearQ(lower, upper, limitQuantity(lower, upper, cfg.quantity))
+	case "exponential":
+		if !cfg.lowerSet {
+			lower = max(1, lower)
+			upper = max(lower, upper)
+		}
+
+		return stat.NewExponential(lower, upper, cfg.factor)
+	}
+
+	return nil, errLayoutUnknown
+}
+
+// Limits the quantity of spans by the quantity of values in the range so that
+// spans are not empty.
+func limitQuantity(lower, upper, quantity int64) int64 {
+	// Range is calculated in floating point to avoid overflow
+	if lower <= upper && float64(upper)-float64(lower)+1 < float64(quantity) {
+		return upper - lower + 1
+	}
+
+	return quantity
+}
+
+func display(cfg config, st *stat.Stat[int64], stdout io.Writer) error {
+	opts := make([]stat.GraphOption, 0)
+
+	if cfg.ascii {
+		opts = append(opts, stat.WithASCII())
+	}
+
+	if cfg.collapse {
+		opts = append(opts, stat.WithCollapse())
+	}
+
+	if cfg.log {
+		opts = append(opts, stat.WithLogarithmic())
+	}
+
+	if cfg.percentage {
+		opts = append(opts, stat.WithPercentage())
+	}
+
+	switch cfg.output {
+	case "graph":
+		return st.GraphWith(stdout, opts...)
+	case "text":
+		return st.Text(stdout, opts...)
+	case "quantiles":
+		return displayQuantiles(cfg, st, stdout)
+	case "json":
+		data, err := st.MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(stdout, string(data))
+
+		return err
+	case "binary":
+		data, err := st.MarshalBinary()
+		if err != nil {
+			return err
+		}
+
+		_, err = stdout.Write(data)
+
+		return err
+	}
+
+	return errOutputUnknown
+}
+
+func displayQuantiles(cfg config, st *stat.Stat[int64], stdout io.Writer) error {
+	builder := new(strings.Builder)
+
+	for _, field := range strings.Split(cfg.quantiles, ",") {
+		quantile, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return err
+		}
+
+		value, err := st.Quantile(quantile)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(builder, "%s %d\n", quantileLabel(quantile), value)
+	}
+
+	_, err := io.WriteString(stdout, builder.String())
+
+	return err
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func runTest(t *testing.T, input string, args ...string) (int, string, string) {
+	t.Helper()
+
+	stdout := new(strings.Builder)
+	stderr := new(strings.Builder)
+
+	code := run(args, strings.NewReader(input), stdout, stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRunText(t *testing.T) {
+	full := strings.Repeat("█", 50)
+	empty := strings.Repeat(" ", 50)
+
+	expected := "" +
+		"[1:1] │" + full + " 1\n" +
+		"[2:2] │" + full + " 1\n" +
+		"[3:3] │" + empty + " 0\n" +
+		"[4:4] │" + full + " 1\n" +
+		"[5:5] │" + full + " 1\n"
+
+	code, stdout, stderr := runTest(t, "1\n\n 2\n4\n5\n", "-output", "text", "-quantity", "7")
+	require.Equal(t, 0, code)
+	require.Empty(t, stderr)
+	require.Equal(t, expected, stdout)
+
+	expected = "" +
+		"[0:1] |" + strings.Repeat("#", 50) + " 2 66.67%\n" +
+		"[2:3] |" + strings.Repeat("#", 25) + strings.Repeat(" ", 25) + " 1 33.33%\n"
+
+	code, stdout, _ = runTest(
+		t,
+		"1\n1\n2\n",
+		"-output", "text", "-ascii", "-percentage", "-lower", "0", "-upper", "3", "-width", "2",
+	)
+	require.Equal(t, 0, code)
+	require.Equal(t, expected, stdout)
+}
+
+func TestRunLayouts(t *testing.T) {
+	code, stdout, _ := runTest(t, "0\n3\n9\n", "-output", "json", "-layout", "exponential", "-factor", "3")
+	require.Equal(t, 0, code)
+	require.JSONEq(
+		t,
+		`{"items":[`+
+			`{"kind":"-Inf","begin":-9223372036854775808,"end":0,"quantity":1},`+
+			`{"kind":"regular","begin":1,"end":2,"quantity":0},`+
+			`{"kind":"regular","begin":3,"end":8,"quantity":1},`+
+			`{"kind":"regular","begin":9,"end":9,"quantity":1}],`+
+			`"summary":{"total":3,"missed":0,"negInf":1,"posInf":0,"min":3,"max":9,"mean":7.25}}`,
+		stdout,
+	)
+
+	code, stdout, _ = runTest(t, "1\n10\n", "-output", "text", "-layout", "linearq", "-quantity", "2", "-ascii")
+	require.Equal(t, 0, code)
+	require.Contains(t, stdout, "[1:5]")
+	require.Contains(t, stdout, "[6:10]")
+
+	code, stdout, _ = runTest(t, "1\n", "-output", "graph", "-log", "-collapse")
+	require.Equal(t, 0, code)
+	require.Contains(t, stdout, "Logarithmic scale")
+}
+
+func TestRunColumn(t *testing.T) {
+	input := "name;value\na; 10\nb;20\nc;30;extra\n"
+
+	code, stdout, stderr := runTest(
+		t,
+		input,
+		"-column", "2", "-separator", ";", "-header", "-output", "quantiles", "-quantiles", "0,0.5,1",
+	)
+	require.Equal(t, 0, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "p0 10\np50 20\np100 30\n", stdout)
+
+	_, err := readValues(config{column: 3, separator: ","}, strings.NewReader("a,1\n"))
+	require.ErrorIs(t, err, errColumnMissing)
+}
+
+func TestRunError(t *testing.T) {
+	for _, test := range []struct {
+		input string
+		args  []string
+		code  int
+	}{
+		{input: "1\n", args: []string{"-unknown"}, code: exitUsage},
+		{input: "a,1\n", args: []string{"-column", "2", "-separator", ""}, code: exitUsage},
+		{input: "a,1\n", args: []string{"-column", "2", "-separator", ";;"}, code: exitUsage},
+		{input: "1\n", args: []string{"-column", "-1"}, code: exitUsage},
+		{input: "1\n", args: []string{"-layout", "square"}, code: exitFailure},
+		{input: "1\n", args: []string{"-output", "xml"}, code: exitFailure},
+		{input: "1\n", args: []string{"-lower", "2", "-upper", "1"}, code: exitFailure},
+		{input: "", args: nil, code: exitFailure},
+		{input: "one\n", args: nil, code: exitFailure},
+		{input: "a,1\n", args: []string{"-column", "3"}, code: exitFailure},
+		{input: "a,b\n", args: []string{"-column", "2"}, code: exitFailure},
+		{input: "a,\"b\n", args: []string{"-column", "2"}, code: exitFailure},
+		{input: "1\n", args: []string{"-output", "quantiles", "-quantiles", "half"}, code: exitFailure},
+		{input: "1\n", args: []string{"-output", "quantiles", "-quantiles", "2"}, code: exitFailure},
+	} {
+		code, _, stderr := runTest(t, test.input, test.args...)
+		require.Equal(t, test.code, code, test.args)
+		require.NotEmpty(t, stderr, test.args)
+	}
+
+	code, _, _ := runTest(t, "", "-h")
+	require.Equal(t, 0, code)
+}
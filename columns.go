@@ -0,0 +1,148 @@
+package stat
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a vertical column chart to the specified writer.
+//
+// Each item is represented by a column one character wide. The maximum height of
+// columns is set by the [WithHeight] option. The vertical axis is labeled with
+// the maximum quantity of occurrences, the horizontal axis is labeled with the
+// labels of the first and last items.
+//
+// If writer is not specified (is nil), the column chart will be written to
+// standard output.
+func (st *Stat[Type]) Columns(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return columns(writer, chr)
+}
+
+func columns[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	units := chr.columnUnits()
+	maximum := chr.maxMagnitude()
+	heights := make([]int, len(chr.entries))
+
+	for id, ntr := range chr.entries {
+		heights[id] = barUnits(chr.magnitude(ntr), maximum, chr.opts.height*units)
+	}
+
+	top := strconv.FormatUint(chr.maxQuantity(), decimalBase)
+	bottom := "0"
+	axisWidth := max(len(top), len(bottom))
+
+	axis := chr.columnAxis()
+
+	builder := new(strings.Builder)
+
+	if chr.opts.logarithmic {
+		builder.WriteString(logarithmicMarker)
+		builder.WriteString("\n")
+	}
+
+	for row := chr.opts.height - 1; row >= 0; row-- {
+		label := ""
+
+		if row == chr.opts.height-1 {
+			label = top
+		}
+
+		builder.WriteString(pad(axisWidth - len(label)))
+		builder.WriteString(label)
+		builder.WriteString(" ")
+		builder.WriteRune(axis[0])
+
+		line := new(strings.Builder)
+
+		for id, height := range heights {
+			if id != 0 {
+				line.WriteString(" ")
+			}
+
+			line.WriteString(chr.columnCell(height - row*units))
+		}
+
+		builder.WriteString(strings.TrimRight(line.String(), " "))
+		builder.WriteString("\n")
+	}
+
+	width := max(0, 2*len(heights)-1)
+
+	builder.WriteString(pad(axisWidth - len(bottom)))
+	builder.WriteString(bottom)
+	builder.WriteString(" ")
+	builder.WriteRune(axis[1])
+	builder.WriteString(strings.Repeat(string(axis[2]), width))
+	builder.WriteString("\n")
+
+	if len(chr.entries) != 0 {
+		first := chr.name(chr.entries[0])
+		last := chr.name(chr.entries[len(chr.entries)-1])
+
+		builder.WriteString(pad(axisWidth + 2))
+		builder.WriteString(first)
+
+		if len(chr.entries) > 1 {
+			gap := width - utf8.RuneCountInString(first) - utf8.RuneCountInString(last)
+
+			builder.WriteString(pad(max(1, gap)))
+			builder.WriteString(last)
+		}
+
+		builder.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+// Returns characters of the axes: vertical line, corner and horizontal line.
+func (chr chart[Type]) columnAxis() []rune {
+	if chr.opts.ascii {
+		return []rune(asciiColumnAxis)
+	}
+
+	return []rune(columnAxis)
+}
+
+// Returns the quantity of units (parts of a character) in a character of column.
+func (chr chart[Type]) columnUnits() int {
+	if chr.opts.ascii {
+		return 1
+	}
+
+	return blockUnits
+}
+
+// Returns the character of column cell filled by the specified quantity of units.
+func (chr chart[Type]) columnCell(units int) string {
+	if units <= 0 {
+		return " "
+	}
+
+	if chr.opts.ascii {
+		return asciiBlock
+	}
+
+	if units >= blockUnits {
+		return fullBlock
+	}
+
+	// Lower blocks are sorted by the quantity of units they fill
+	return string([]rune(lowerBlocks)[units-1])
+}
@@ -0,0 +1,70 @@
+package stat
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatColumns(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Columns(buffer, WithHeight(4)))
+	requireGolden(t, "columns.golden", buffer.String())
+}
+
+func TestStatColumnsASCII(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Columns(buffer, WithHeight(4), WithASCII(), WithCollapse()))
+	requireGolden(t, "columns_ascii.golden", buffer.String())
+}
+
+func TestStatColumnsLogarithmic(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Columns(buffer, WithHeight(3), WithLogarithmic()))
+	requireGolden(t, "columns_logarithmic.golden", buffer.String())
+}
+
+func TestStatColumnsDefaultHeight(t *testing.T) {
+	stat, err := NewLinearQ(1, 100, 1)
+	require.NoError(t, err)
+
+	stat.Inc(1)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Columns(buffer))
+	requireGolden(t, "columns_default.golden", buffer.String())
+}
+
+func TestStatColumnsSingle(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Columns(buffer, WithHeight(2), WithTop(1), WithCollapse()))
+	requireGolden(t, "columns_single.golden", buffer.String())
+}
+
+func TestStatColumnsError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.Columns(nil, WithHeight(-1)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.Columns(nil))
+
+	os.Stdout = stdout
+}
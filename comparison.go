@@ -0,0 +1,339 @@
+package stat
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+// Difference between the quantities of occurrences of an item in two statistics.
+type Difference[Type constraints.Integer] struct {
+	// Kind (purpose) of item
+	Kind ItemKind
+
+	// Span of values for which the quantities of occurrences are collected
+	Span span.Span[Type]
+
+	// Quantity of occurrences in the baseline statistics
+	Baseline uint64
+
+	// Quantity of occurrences in the candidate statistics
+	Candidate uint64
+
+	// Percentage of the total quantity of occurrences in the baseline statistics
+	BaselinePercentage float64
+
+	// Percentage of the total quantity of occurrences in the candidate statistics
+	CandidatePercentage float64
+}
+
+// Returns the difference between the candidate and baseline percentages.
+func (dfr Difference[Type]) PercentageDelta() float64 {
+	return dfr.CandidatePercentage - dfr.BaselinePercentage
+}
+
+// Comparison of two statistics.
+type Comparison[Type constraints.Integer] struct {
+	differences []Difference[Type]
+}
+
+// Compares the baseline and candidate statistics.
+//
+// Items of statistics are aligned by kind and span. Items whose spans are present
+// in only one of the statistics are compared with empty items.
+//
+// Comparison is made on a snapshot of the statistics items, subsequent changes of
+// the statistics do not affect it.
+func Compare[Type constraints.Integer](baseline, candidate *Stat[Type]) *Comparison[Type] {
+	cpr := &Comparison[Type]{
+		differences: alignItems(baseline.Items(), candidate.Items()),
+	}
+
+	return cpr
+}
+
+func alignItems[Type constraints.Integer](baseline, candidate []Item[Type]) []Difference[Type] {
+	baselineTotal := itemsTotal(baseline)
+	candidateTotal := itemsTotal(candidate)
+
+	differences := make([]Difference[Type], 0, max(len(baseline), len(candidate)))
+
+	for len(baseline) != 0 || len(candidate) != 0 {
+		order := 0
+
+		switch {
+		case len(baseline) == 0:
+			order = 1
+		case len(candidate) == 0:
+			order = -1
+		default:
+			order = compareItems(baseline[0], candidate[0])
+		}
+
+		dfr := Difference[Type]{}
+
+		if order <= 0 {
+			dfr.Kind = baseline[0].Kind
+			dfr.Span = baseline[0].Span
+			dfr.Baseline = baseline[0].Quantity
+			dfr.BaselinePercentage = percentageOf(baseline[0].Quantity, baselineTotal)
+
+			baseline = baseline[1:]
+		}
+
+		if order >= 0 {
+			dfr.Kind = candidate[0].Kind
+			dfr.Span = candidate[0].Span
+			dfr.Candidate = candidate[0].Quantity
+			dfr.CandidatePercentage = percentageOf(candidate[0].Quantity, candidateTotal)
+
+			candidate = candidate[1:]
+		}
+
+		differences = append(differences, dfr)
+	}
+
+	return differences
+}
+
+// Compares items in the order of the list of statistics items: missed item first,
+// other items by span.
+func compareItems[Type constraints.Integer](first, second Item[Type]) int {
+	return cmp.Or(
+		cmp.Compare(itemRank(first), itemRank(second)),
+		cmp.Compare(first.Span.Begin, second.Span.Begin),
+		cmp.Compare(first.Span.End, second.Span.End),
+		cmp.Compare(first.Kind, second.Kind),
+	)
+}
+
+func itemRank[Type constraints.Integer](item Item[Type]) int {
+	if item.Kind == ItemKindMissed {
+		return 0
+	}
+
+	return 1
+}
+
+func itemsTotal[Type constraints.Integer](items []Item[Type]) float64 {
+	total := 0.0
+
+	for _, item := range items {
+		total += float64(item.Quantity)
+	}
+
+	return total
+}
+
+func percentageOf(quantity uint64, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return percent * float64(quantity) / total
+}
+
+// Returns a list of differences between items of statistics.
+func (cpr *Comparison[Type]) Differences() []Difference[Type] {
+	return slices.Clone(cpr.differences)
+}
+
+func (cpr *Comparison[Type]) baseline() []Item[Type] {
+	items := make([]Item[Type], len(cpr.differences))
+
+	for id, dfr := range cpr.differences {
+		items[id] = Item[Type]{Kind: dfr.Kind, Span: dfr.Span, Quantity: dfr.Baseline}
+	}
+
+	return items
+}
+
+func (cpr *Comparison[Type]) candidate() []Item[Type] {
+	items := make([]Item[Type], len(cpr.differences))
+
+	for id, dfr := range cpr.differences {
+		items[id] = Item[Type]{Kind: dfr.Kind, Span: dfr.Span, Quantity: dfr.Candidate}
+	}
+
+	return items
+}
+
+// Writes comparison as a text chart of paired bars to the specified writer.
+//
+// For each item, the bar of the baseline statistics is followed by the bar of the
+// candidate statistics, both bars are drawn on the same scale. Bars are
+// accompanied by the quantities of occurrences and the percentages of the total
+// quantities of occurrences, the line of candidate statistics is also accompanied
+// by the differences between them.
+//
+// Options are the same as for [Stat.Text], except [WithTop] and [WithQuantiles],
+// which are not used. The [WithCollapse] option collapses runs of items that are
+// empty in both statistics.
+//
+// If writer is not specified (is nil), the chart will be written to standard
+// output.
+func (cpr *Comparison[Type]) Text(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	options, err := newGraphOpts(opts)
+	if err != nil {
+		return err
+	}
+
+	// Collapsing is performed over both statistics together
+	opts = append(slices.Clip(opts), withoutArrangement())
+
+	baseline, err := newChart(cpr.baseline(), opts)
+	if err != nil {
+		return err
+	}
+
+	candidate, err := newChart(cpr.candidate(), opts)
+	if err != nil {
+		return err
+	}
+
+	if options.collapse {
+		collapsePair(&baseline, &candidate)
+	}
+
+	return comparison(writer, baseline, candidate)
+}
+
+// Disables the options that change the set of displayed items.
+func withoutArrangement() GraphOption {
+	return func(opts *graphOpts) {
+		opts.collapse = false
+		opts.quantiles = nil
+		opts.top = 0
+	}
+}
+
+// Collapses runs of entries that are empty in both charts.
+func collapsePair[Type constraints.Integer](baseline, candidate *chart[Type]) {
+	baselineEntries := make([]entry[Type], 0, len(baseline.entries))
+	candidateEntries := make([]entry[Type], 0, len(candidate.entries))
+
+	for id, first := range baseline.entries {
+		second := candidate.entries[id]
+
+		empty := first.item.Kind == ItemKindRegular &&
+			first.item.Quantity == 0 &&
+			second.item.Quantity == 0
+
+		if !empty {
+			baselineEntries = append(baselineEntries, first)
+			candidateEntries = append(candidateEntries, second)
+
+			continue
+		}
+
+		first.collapsed = true
+		second.collapsed = true
+
+		if last := len(baselineEntries) - 1; last >= 0 && baselineEntries[last].collapsed {
+			baselineEntries[last] = first
+			candidateEntries[last] = second
+
+			continue
+		}
+
+		baselineEntries = append(baselineEntries, first)
+		candidateEntries = append(candidateEntries, second)
+	}
+
+	baseline.entries = baselineEntries
+	candidate.entries = candidateEntries
+}
+
+func comparison[Type constraints.Integer](writer io.Writer, baseline, candidate chart[Type]) error {
+	names := make([]string, len(baseline.entries))
+
+	nameWidth := 0
+	quantityWidth := 0
+	percentageWidth := 0
+
+	for id, ntr := range baseline.entries {
+		names[id] = baseline.name(ntr)
+		nameWidth = max(nameWidth, utf8.RuneCountInString(names[id]))
+	}
+
+	for _, chr := range []chart[Type]{baseline, candidate} {
+		for _, ntr := range chr.entries {
+			quantityWidth = max(quantityWidth, len(strconv.FormatUint(ntr.quantity, decimalBase)))
+			percentageWidth = max(percentageWidth, len(formatPercentage(chr.percentage(ntr))))
+		}
+	}
+
+	sideWidth := max(len(comparisonBaseline), len(comparisonCandidate))
+	maximum := max(baseline.maxMagnitude(), candidate.maxMagnitude())
+
+	builder := new(strings.Builder)
+
+	if baseline.opts.logarithmic {
+		builder.WriteString(logarithmicMarker)
+		builder.WriteString("\n")
+	}
+
+	writeSide := func(name string, side string, chr chart[Type], ntr entry[Type]) {
+		bar, length := chr.textBar(ntr, maximum)
+		quantity := strconv.FormatUint(ntr.quantity, decimalBase)
+		percentage := formatPercentage(chr.percentage(ntr))
+
+		builder.WriteString(name)
+		builder.WriteString(pad(nameWidth - utf8.RuneCountInString(name)))
+		builder.WriteString(" ")
+		builder.WriteString(side)
+		builder.WriteString(pad(sideWidth - len(side)))
+		builder.WriteString(" ")
+		builder.WriteString(chr.textSeparator())
+		builder.WriteString(bar)
+		builder.WriteString(pad(chr.opts.width - length))
+		builder.WriteString(" ")
+		builder.WriteString(pad(quantityWidth - len(quantity)))
+		builder.WriteString(quantity)
+		builder.WriteString(" ")
+		builder.WriteString(pad(percentageWidth - len(percentage)))
+		builder.WriteString(percentage)
+	}
+
+	for id, first := range baseline.entries {
+		second := candidate.entries[id]
+
+		writeSide(names[id], comparisonBaseline, baseline, first)
+		builder.WriteString("\n")
+
+		writeSide("", comparisonCandidate, candidate, second)
+		builder.WriteString(" ")
+		builder.WriteString(formatDelta(first.quantity, second.quantity))
+		builder.WriteString(" ")
+		builder.WriteString(formatPercentageDelta(candidate.percentage(second) - baseline.percentage(first)))
+		builder.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+func formatDelta(baseline, candidate uint64) string {
+	if candidate >= baseline {
+		return "+" + strconv.FormatUint(candidate-baseline, decimalBase)
+	}
+
+	return "-" + strconv.FormatUint(baseline-candidate, decimalBase)
+}
+
+func formatPercentageDelta(delta float64) string {
+	return fmt.Sprintf("%+.2f%%", delta)
+}
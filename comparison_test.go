@@ -0,0 +1,139 @@
+package stat
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	baseline, err := NewLinear(1, 30, 10)
+	require.NoError(t, err)
+
+	candidate, err := New(
+		[]span.Span[int]{
+			{Begin: 1, End: 10},
+			{Begin: 11, End: 15},
+			{Begin: 16, End: 20},
+			{Begin: 25, End: 30},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	baseline.Inc(0)
+	baseline.Inc(1)
+	baseline.Inc(2)
+	baseline.Inc(12)
+
+	candidate.Inc(1)
+	candidate.Inc(12)
+	candidate.Inc(22)
+	candidate.Inc(31)
+
+	expected := []Difference[int]{
+		{
+			Kind:                ItemKindMissed,
+			Candidate:           1,
+			CandidatePercentage: 25,
+		},
+		{
+			Kind:               ItemKindNegInf,
+			Span:               span.Span[int]{Begin: math.MinInt, End: 0},
+			Baseline:           1,
+			BaselinePercentage: 25,
+		},
+		{
+			Kind:                ItemKindRegular,
+			Span:                span.Span[int]{Begin: 1, End: 10},
+			Baseline:            2,
+			Candidate:           1,
+			BaselinePercentage:  50,
+			CandidatePercentage: 25,
+		},
+		{
+			Kind:                ItemKindRegular,
+			Span:                span.Span[int]{Begin: 11, End: 15},
+			Candidate:           1,
+			CandidatePercentage: 25,
+		},
+		{
+			Kind:               ItemKindRegular,
+			Span:               span.Span[int]{Begin: 11, End: 20},
+			Baseline:           1,
+			BaselinePercentage: 25,
+		},
+		{
+			Kind: ItemKindRegular,
+			Span: span.Span[int]{Begin: 16, End: 20},
+		},
+		{
+			Kind: ItemKindRegular,
+			Span: span.Span[int]{Begin: 21, End: 30},
+		},
+		{
+			Kind: ItemKindRegular,
+			Span: span.Span[int]{Begin: 25, End: 30},
+		},
+		{
+			Kind:                ItemKindPosInf,
+			Span:                span.Span[int]{Begin: 31, End: math.MaxInt},
+			Candidate:           1,
+			CandidatePercentage: 25,
+		},
+	}
+
+	differences := Compare(baseline, candidate).Differences()
+	require.Equal(t, expected, differences)
+	require.InDelta(t, -25.0, differences[2].PercentageDelta(), 0)
+}
+
+func TestComparisonText(t *testing.T) {
+	baseline := newGraphTestStat(t)
+
+	candidate, err := NewLinear(1, 60, 10)
+	require.NoError(t, err)
+
+	candidate.Inc(2)
+	candidate.Inc(42)
+	candidate.Inc(53)
+	candidate.Inc(54)
+	candidate.Inc(61)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, Compare(baseline, candidate).Text(buffer, WithWidth(10), WithCollapse()))
+	requireGolden(t, "comparison.golden", buffer.String())
+
+	buffer.Reset()
+
+	require.NoError(
+		t,
+		Compare(baseline, candidate).Text(buffer, WithWidth(10), WithASCII(), WithCumulative(), WithTop(1)),
+	)
+	requireGolden(t, "comparison_cumulative.golden", buffer.String())
+}
+
+func TestComparisonTextError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, Compare(stat, stat).Text(nil, WithWidth(-1)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, Compare(stat, stat).Text(nil))
+
+	os.Stdout = stdout
+}
+
+func TestFormatDelta(t *testing.T) {
+	require.Equal(t, "+0", formatDelta(1, 1))
+	require.Equal(t, "+18446744073709551615", formatDelta(0, 18446744073709551615))
+	require.Equal(t, "-18446744073709551615", formatDelta(18446744073709551615, 0))
+	require.Equal(t, "-1.50%", formatPercentageDelta(-1.5))
+}
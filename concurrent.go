@@ -0,0 +1,104 @@
+package stat
+
+import (
+	"expvar"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Statistics safe for concurrent use.
+type Concurrent[Type constraints.Integer] struct {
+	mutex sync.RWMutex
+	stat  *Stat[Type]
+}
+
+// Creates an instance of statistics safe for concurrent use.
+//
+// Specified statistics must not be used directly after that.
+func NewConcurrent[Type constraints.Integer](st *Stat[Type]) *Concurrent[Type] {
+	cnc := &Concurrent[Type]{
+		stat: st,
+	}
+
+	return cnc
+}
+
+// Increases the quantity of occurrences of the specified value.
+func (cnc *Concurrent[Type]) Inc(value Type) {
+	cnc.mutex.Lock()
+	defer cnc.mutex.Unlock()
+
+	cnc.stat.Inc(value)
+}
+
+// Increases the quantity of occurrences of the specified value by the specified
+// quantity.
+//
+// See [Stat.Add] for details.
+func (cnc *Concurrent[Type]) Add(value Type, quantity uint64) error {
+	cnc.mutex.Lock()
+	defer cnc.mutex.Unlock()
+
+	return cnc.stat.Add(value, quantity)
+}
+
+// Returns the error recorded on overflow of the quantity of occurrences.
+//
+// See [Stat.Err] for details.
+func (cnc *Concurrent[Type]) Err() error {
+	cnc.mutex.RLock()
+	defer cnc.mutex.RUnlock()
+
+	return cnc.stat.Err()
+}
+
+// Returns a list of statistics items.
+func (cnc *Concurrent[Type]) Items() []Item[Type] {
+	cnc.mutex.RLock()
+	defer cnc.mutex.RUnlock()
+
+	return cnc.stat.Items()
+}
+
+// Returns a copy of statistics that is not changed by subsequent increments.
+func (cnc *Concurrent[Type]) Snapshot() *Stat[Type] {
+	cnc.mutex.RLock()
+	defer cnc.mutex.RUnlock()
+
+	snapshot := cnc.stat.layout()
+
+	// Layouts are the same and the copy has no occurrences, so merging cannot fail
+	_ = snapshot.merge(cnc.stat)
+
+	return snapshot
+}
+
+// Returns statistics items and summary encoded in JSON.
+func (cnc *Concurrent[Type]) MarshalJSON() ([]byte, error) {
+	cnc.mutex.RLock()
+	defer cnc.mutex.RUnlock()
+
+	return cnc.stat.MarshalJSON()
+}
+
+// Returns statistics items and summary encoded in JSON.
+func (cnc *Concurrent[Type]) String() string {
+	cnc.mutex.RLock()
+	defer cnc.mutex.RUnlock()
+
+	return cnc.stat.String()
+}
+
+// Publishes statistics with the specified name by [expvar.Publish].
+//
+// Returned statistics must be used for increments instead of the specified one,
+// so that reading of published statistics is safe. Like [expvar.Publish], it
+// panics if a variable with the specified name is already published.
+func Publish[Type constraints.Integer](name string, st *Stat[Type]) *Concurrent[Type] {
+	cnc := NewConcurrent(st)
+
+	expvar.Publish(name, cnc)
+
+	return cnc
+}
@@ -0,0 +1,79 @@
+package stat
+
+import (
+	"encoding/json"
+	"expvar"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrent(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	cnc := NewConcurrent(stat)
+
+	wg := new(sync.WaitGroup)
+
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for value := range 1000 {
+				cnc.Inc(value%100 + 1)
+
+				_ = cnc.String()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	snapshot := cnc.Snapshot()
+
+	cnc.Inc(1)
+
+	require.Equal(t, uint64(400), snapshot.Items()[0].Quantity)
+	require.Equal(t, uint64(401), cnc.Items()[0].Quantity)
+
+	data, err := json.Marshal(cnc)
+	require.NoError(t, err)
+	require.JSONEq(t, cnc.String(), string(data))
+}
+
+func TestConcurrentOverflow(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	stat.SetOverflow(OverflowError)
+
+	cnc := NewConcurrent(stat)
+
+	require.NoError(t, cnc.Add(1, math.MaxUint64))
+	require.NoError(t, cnc.Err())
+
+	cnc.Inc(1)
+	require.ErrorIs(t, cnc.Err(), ErrQuantityOverflow)
+	require.ErrorIs(t, cnc.Add(1, 1), ErrQuantityOverflow)
+}
+
+func TestPublish(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	cnc := Publish("stat_test_publish", stat)
+
+	cnc.Inc(1)
+
+	published := expvar.Get("stat_test_publish")
+	require.NotNil(t, published)
+	require.JSONEq(t, cnc.String(), published.String())
+	require.Contains(t, published.String(), `"quantity":1`)
+
+	require.Panics(t, func() { Publish("stat_test_publish", stat) })
+}
@@ -1,6 +1,62 @@
 package stat
 
 const (
-	decimalBase          = 10
-	specialItemsQuantity = 3 // Missed, negative and positive infinities
+	asciiBlock             = "#"
+	asciiCollapsedLabel    = "..."
+	asciiColumnAxis        = "|+-"
+	asciiLevels            = ".:-=+*#@"
+	autoDefaultFactor      = 2
+	autoDefaultQuantity    = 20
+	barResolution          = 1000
+	barValueLimit          = 1<<31 - 1 // Bar values fit in int on all platforms
+	binaryHeaderSize       = 2         // Version and type of values
+	binaryItemMinSize      = 3         // Begin, end and quantity occupy at least one byte each
+	binaryMagic            = "STAT"
+	binarySignedFlag       = 0x80
+	binaryVersion          = 1
+	blockUnits             = 8 // Eighths of a character
+	collapsedLabel         = "…"
+	columnAxis             = "│└─"
+	comparisonBaseline     = "baseline"
+	comparisonCandidate    = "candidate"
+	decimalBase            = 10
+	defaultHeight          = 10
+	defaultImageHeight     = 400
+	defaultImageWidth      = 800
+	defaultWidth           = 50
+	fontScale              = 2
+	fullBlock              = "█"
+	formatHTML             = "html"
+	formatJSON             = "json"
+	formatPrometheus       = "prometheus"
+	formatText             = "text"
+	gammaEpsilon           = 1e-14
+	gammaIterations        = 1000
+	gammaTiny              = 1e-300 // Substitute of zero denominators
+	glyphHeight            = 5
+	glyphWidth             = 3
+	heatmapShades          = "░▒▓█"
+	htmlNoValue            = "—"
+	imageBarGap            = 0.1 // Fraction of the space allocated for a bar
+	imageMarginBottom      = 80
+	imageMarginLeft        = 60
+	imageMarginRight       = 20
+	imageMarginTop         = 30
+	ksCorrection           = 0.12 // Stephens correction of the effective sample size
+	ksCorrectionScale      = 0.11
+	ksIterations           = 100
+	ksMinimumLambda        = 0.2 // Kolmogorov distribution is indistinguishable from 1 below
+	logarithmicMarker      = "Logarithmic scale"
+	lowerBlocks            = "▁▂▃▄▅▆▇█"
+	partialBlocks          = "▏▎▍▌▋▊▉"
+	percent                = 100
+	pngDashGap             = 3
+	pngDashLength          = 4
+	pngTextIndent          = 4
+	quantileLabelPrecision = 10
+	roundingTolerance      = 1e-12
+	specialItemsQuantity   = 3 // Missed, negative and positive infinities
+	svgLabelIndent         = 12
+	svgPrecision           = 2
+	svgTextIndent          = 4
 )
@@ -3,9 +3,32 @@ package stat
 import "errors"
 
 var (
-	ErrItemsQuantityNegative = errors.New("items quantity is negative")
-	ErrItemsQuantityZero     = errors.New("items quantity is zero")
-	ErrLowerGreaterUpper     = errors.New("lower value is greater than upper")
-	ErrSpansListEmpty        = errors.New("an empty list of spans was specified")
-	ErrSpansSequenceUnsorted = errors.New("spans sequence is not sorted")
+	ErrFactoryNil             = errors.New("factory function is not specified")
+	ErrDataInvalid            = errors.New("data is invalid")
+	ErrFactorTooSmall         = errors.New("factor is less than two")
+	ErrFormatUnknown          = errors.New("format is unknown")
+	ErrHeightNegative         = errors.New("height is negative")
+	ErrItemKindUnknown        = errors.New("item kind is unknown")
+	ErrItemsQuantityNegative  = errors.New("items quantity is negative")
+	ErrItemsQuantityZero      = errors.New("items quantity is zero")
+	ErrLabelFormatterMismatch = errors.New("type of label formatter does not match type of statistics")
+	ErrLayoutsMismatch        = errors.New("layouts of statistics do not match")
+	ErrLimitNegative          = errors.New("limit is negative")
+	ErrLimitZero              = errors.New("limit is zero")
+	ErrLowerGreaterUpper      = errors.New("lower value is greater than upper")
+	ErrLowerNotPositive       = errors.New("lower value is not positive")
+	ErrMetricNameCollision    = errors.New("metric name collides with metric name of registered statistics")
+	ErrMetricNameInvalid      = errors.New("metric name is invalid")
+	ErrNameDuplicated         = errors.New("name is already registered")
+	ErrNameEmpty              = errors.New("name is empty")
+	ErrNoOccurrences          = errors.New("there are no occurrences of values")
+	ErrProbabilityInvalid     = errors.New("probability is negative, not a number or infinite in sum")
+	ErrQuantileInvalid        = errors.New("quantile is out of range [0, 1]")
+	ErrQuantityOverflow       = errors.New("quantity of occurrences overflowed")
+	ErrSpansListEmpty         = errors.New("an empty list of spans was specified")
+	ErrSpansSequenceUnsorted  = errors.New("spans sequence is not sorted")
+	ErrTopNegative            = errors.New("quantity of top items is negative")
+	ErrTypeMismatch           = errors.New("type of values does not match")
+	ErrWarmupNotPositive      = errors.New("warm-up sample size is not positive")
+	ErrWidthNegative          = errors.New("width is negative")
 )
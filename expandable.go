@@ -0,0 +1,316 @@
+package stat
+
+import (
+	"slices"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/akramarenkov/safe"
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+// Parameters of the layout extension of expandable statistics.
+type expansion[Type constraints.Integer] struct {
+	// Kind of extended layout
+	kind LayoutKind
+
+	// Width of spans of the linear layout or factor of the exponential layout
+	step Type
+
+	// Beginning of the first span of the initial layout
+	origin Type
+
+	// Maximum quantity of items
+	limit int
+
+	// Quantity of spans added before the initial layout
+	prepended int
+
+	// Quantity of layout extensions
+	growths int
+}
+
+// Creates a linear statistics whose items have the specified width and which
+// extends its layout with items of the same width when values beyond its range
+// occur.
+//
+// Quantity of items is limited by the specified limit, occurrences of values
+// beyond the limited layout are counted in the -Inf and +Inf items. Limit must be
+// positive.
+func NewExpandableLinear[Type constraints.Integer](lower, upper, width Type, limit int) (*Stat[Type], error) {
+	if limit < 0 {
+		return nil, ErrLimitNegative
+	}
+
+	if limit == 0 {
+		return nil, ErrLimitZero
+	}
+
+	st, err := NewLinear(lower, upper, width)
+	if err != nil {
+		return nil, err
+	}
+
+	st.expansion = &expansion[Type]{
+		kind:   LayoutKindLinear,
+		step:   width,
+		origin: lower,
+		limit:  limit,
+	}
+
+	return st, nil
+}
+
+// Creates an exponential statistics whose items have widths increasing by the
+// specified factor and which extends its layout with items continuing this
+// sequence when values beyond its range occur.
+//
+// Layout is not extended to values less than one. If the last item is truncated
+// by the upper value, it is completed first.
+//
+// Quantity of items is limited by the specified limit, occurrences of values
+// beyond the limited layout are counted in the -Inf and +Inf items. Limit must be
+// positive.
+func NewExpandableExponential[Type constraints.Integer](lower, upper, factor Type, limit int) (*Stat[Type], error) {
+	if limit < 0 {
+		return nil, ErrLimitNegative
+	}
+
+	if limit == 0 {
+		return nil, ErrLimitZero
+	}
+
+	st, err := NewExponential(lower, upper, factor)
+	if err != nil {
+		return nil, err
+	}
+
+	st.expansion = &expansion[Type]{
+		kind:   LayoutKindExponential,
+		step:   factor,
+		origin: lower,
+		limit:  limit,
+	}
+
+	return st, nil
+}
+
+// Returns the quantity of extensions of the layout of expandable statistics.
+//
+// For not expandable statistics it is always zero.
+func (st *Stat[Type]) Growths() int {
+	if st.expansion == nil {
+		return 0
+	}
+
+	return st.expansion.growths
+}
+
+// Extends the layout so that it covers the specified value, as far as the limit
+// allows. Returns true if the layout has been changed.
+func (st *Stat[Type]) expand(value Type) bool {
+	if st.expansion == nil {
+		return false
+	}
+
+	var grown bool
+
+	if value < st.items[st.lower()].Span.Begin {
+		grown = st.expandDown(value)
+	} else {
+		grown = st.expandUp(value)
+	}
+
+	if !grown {
+		return false
+	}
+
+	st.expansion.growths++
+
+	if st.expansion.kind == LayoutKindLinear {
+		st.predictor = linearPredictor(st.expansion.origin, st.expansion.step, st.expansion.prepended)
+	}
+
+	st.prepare()
+
+	return true
+}
+
+func (st *Stat[Type]) expandUp(value Type) bool {
+	grown := false
+
+	// Last item can be truncated by the upper value of the initial layout
+	last := &st.items[st.upper()]
+
+	if end := st.expansion.end(last.Span.Begin); end > last.Span.End {
+		last.Span.End = end
+		grown = true
+	}
+
+	if value <= last.Span.End {
+		return grown
+	}
+
+	count := st.expansion.countUp(last.Span.End, value, len(st.items))
+
+	st.items = slices.Grow(st.items, count)
+
+	for range count {
+		if value <= st.items[st.upper()].Span.End {
+			break
+		}
+
+		begin := st.items[st.upper()].Span.End + 1
+
+		item := Item[Type]{
+			Kind: ItemKindRegular,
+			Span: span.Span[Type]{Begin: begin, End: st.expansion.end(begin)},
+		}
+
+		st.items = append(st.items, item)
+		grown = true
+	}
+
+	return grown
+}
+
+func (st *Stat[Type]) expandDown(value Type) bool {
+	begin := st.items[st.lower()].Span.Begin
+	count := st.expansion.countDown(begin, value, len(st.items))
+
+	prepended := make([]Item[Type], 0, count)
+
+	for range count {
+		if value >= begin {
+			break
+		}
+
+		previous, possible := st.expansion.begin(begin)
+		if !possible {
+			break
+		}
+
+		item := Item[Type]{
+			Kind: ItemKindRegular,
+			Span: span.Span[Type]{Begin: previous, End: begin - 1},
+		}
+
+		prepended = append(prepended, item)
+		begin = previous
+	}
+
+	if len(prepended) == 0 {
+		return false
+	}
+
+	slices.Reverse(prepended)
+
+	st.items = slices.Insert(st.items, 0, prepended...)
+	st.expansion.prepended += len(prepended)
+
+	return true
+}
+
+// Returns the quantity of items that should be added after the item ending with
+// the specified value to cover the specified value, but not more than the limit
+// allows for the layout with the specified quantity of items.
+func (exp *expansion[Type]) countUp(end, value Type, quantity int) int {
+	available := max(exp.limit-quantity, 0)
+
+	if exp.kind == LayoutKindLinear {
+		return exp.countLinear(safe.Dist(value, end), available)
+	}
+
+	// Quantity of exponential items grows logarithmically with the distance
+	count := 0
+
+	for ; value > end && count < available; count++ {
+		end = exp.end(end + 1)
+	}
+
+	return count
+}
+
+// Returns the quantity of items that should be added before the item beginning
+// with the specified value to cover the specified value, but not more than the
+// limit allows for the layout with the specified quantity of items.
+func (exp *expansion[Type]) countDown(begin, value Type, quantity int) int {
+	available := max(exp.limit-quantity, 0)
+
+	if exp.kind == LayoutKindLinear {
+		return exp.countLinear(safe.Dist(begin, value), available)
+	}
+
+	count := 0
+
+	for ; value < begin && count < available; count++ {
+		previous, possible := exp.begin(begin)
+		if !possible {
+			break
+		}
+
+		begin = previous
+	}
+
+	return count
+}
+
+// Returns the quantity of linear items needed to cover the specified positive
+// distance, but not more than the specified available quantity.
+func (exp *expansion[Type]) countLinear(distance uint64, available int) int {
+	if needed := (distance-1)/uint64(exp.step) + 1; needed < uint64(available) {
+		return int(needed)
+	}
+
+	return available
+}
+
+// Returns the end of the span beginning with the specified value.
+func (exp *expansion[Type]) end(begin Type) Type {
+	if exp.kind == LayoutKindExponential {
+		next, err := safe.Mul(begin, exp.step)
+		if err != nil {
+			_, maximum := intspec.Range[Type]()
+			return maximum
+		}
+
+		return next - 1
+	}
+
+	end, err := safe.Add(begin, exp.step-1)
+	if err != nil {
+		_, maximum := intspec.Range[Type]()
+		return maximum
+	}
+
+	return end
+}
+
+// Returns the beginning of the span preceding the span beginning with the
+// specified value and false if there is no such span.
+func (exp *expansion[Type]) begin(next Type) (Type, bool) {
+	if exp.kind == LayoutKindExponential {
+		begin := next / exp.step
+		return begin, begin > 0
+	}
+
+	begin, err := safe.Sub(next, exp.step)
+	if err != nil {
+		minimum, _ := intspec.Range[Type]()
+		return minimum, true
+	}
+
+	return begin, true
+}
+
+// Creates a predictor for the linear layout with the specified quantity of spans
+// preceding the span beginning with the origin value.
+func linearPredictor[Type constraints.Integer](origin, width Type, prepended int) Predictor[Type] {
+	return func(value Type) uint64 {
+		if value >= origin {
+			return uint64(prepended) + safe.Dist(value, origin)/uint64(width)
+		}
+
+		return uint64(prepended) - 1 - (safe.Dist(origin, value)-1)/uint64(width)
+	}
+}
@@ -0,0 +1,204 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func requirePredictorValid[Type int | int8 | int64 | uint16](t *testing.T, st *Stat[Type]) {
+	t.Helper()
+
+	lower := st.items[st.lower()].Span.Begin
+	upper := st.items[st.upper()].Span.End
+
+	for value := lower; ; value++ {
+		item := st.slot(st.locate(value))
+
+		require.Equal(t, ItemKindRegular, item.Kind, value)
+		require.LessOrEqual(t, item.Span.Begin, value)
+		require.GreaterOrEqual(t, item.Span.End, value)
+
+		if value == upper {
+			break
+		}
+	}
+}
+
+func TestExpandableLinear(t *testing.T) {
+	st, err := NewExpandableLinear(1, 25, 10, 10)
+	require.NoError(t, err)
+	require.Zero(t, st.Growths())
+
+	st.Inc(5)
+	st.Inc(45)
+
+	require.Equal(t, 1, st.Growths())
+	requirePredictorValid(t, st)
+
+	st.Inc(-15)
+	st.Inc(-15)
+	st.Inc(25)
+	st.Inc(26)
+
+	require.Equal(t, 2, st.Growths())
+	requirePredictorValid(t, st)
+
+	expected := []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: -19, End: -10}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: -9, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 21, End: 30}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 31, End: 40}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 41, End: 50}},
+	}
+
+	require.Equal(t, expected, st.Items())
+}
+
+func TestExpandableLinearLimit(t *testing.T) {
+	st, err := NewExpandableLinear(1, 20, 10, 3)
+	require.NoError(t, err)
+
+	st.Inc(100)
+	st.Inc(200)
+	st.Inc(-100)
+
+	require.Equal(t, 1, st.Growths())
+	requirePredictorValid(t, st)
+
+	expected := []Item[int]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 21, End: 30}},
+		{Kind: ItemKindPosInf, Quantity: 2, Span: span.Span[int]{Begin: 31, End: math.MaxInt}},
+	}
+
+	require.Equal(t, expected, st.Items())
+}
+
+func TestExpandableLinearBoundaries(t *testing.T) {
+	st, err := NewExpandableLinear[int8](0, 99, 50, 10)
+	require.NoError(t, err)
+
+	st.Inc(math.MaxInt8)
+	st.Inc(math.MinInt8)
+
+	require.Equal(t, 2, st.Growths())
+	requirePredictorValid(t, st)
+
+	expected := []Item[int8]{
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int8]{Begin: -128, End: -101}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int8]{Begin: -100, End: -51}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int8]{Begin: -50, End: -1}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int8]{Begin: 0, End: 49}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int8]{Begin: 50, End: 99}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int8]{Begin: 100, End: 127}},
+	}
+
+	require.Equal(t, expected, st.Items())
+}
+
+func TestExpandableExponential(t *testing.T) {
+	st, err := NewExpandableExponential[uint16](8, 100, 2, 20)
+	require.NoError(t, err)
+
+	st.Inc(300)
+	st.Inc(0)
+	st.Inc(1)
+
+	require.Equal(t, 2, st.Growths())
+	requirePredictorValid(t, st)
+
+	expected := []Item[uint16]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[uint16]{Begin: 0, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[uint16]{Begin: 1, End: 1}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 2, End: 3}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 4, End: 7}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 8, End: 15}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 16, End: 31}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 32, End: 63}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 64, End: 127}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[uint16]{Begin: 128, End: 255}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[uint16]{Begin: 256, End: 511}},
+	}
+
+	require.Equal(t, expected, st.Items())
+
+	st.Inc(math.MaxUint16)
+
+	require.Equal(t, 3, st.Growths())
+	require.Equal(t, uint16(math.MaxUint16), st.items[st.upper()].Span.End)
+}
+
+func TestExpandableFar(t *testing.T) {
+	st, err := NewExpandableLinear[int64](0, 10, 1, 100)
+	require.NoError(t, err)
+
+	st.Inc(1 << 40)
+	st.Inc(-1 << 40)
+
+	require.Equal(t, 1, st.Growths())
+	require.Len(t, st.items, 100)
+	require.Equal(t, uint64(1), st.negInf.Quantity)
+	require.Equal(t, uint64(1), st.posInf.Quantity)
+	requirePredictorValid(t, st)
+
+	wide, err := NewExpandableLinear[int64](0, 1<<30-1, 1<<30, 1<<30)
+	require.NoError(t, err)
+
+	wide.Inc(1 << 40)
+	wide.Inc(-1 << 40)
+
+	require.Equal(t, 2, wide.Growths())
+	require.Len(t, wide.items, 2049)
+	require.Equal(t, int64(-1<<40), wide.items[wide.lower()].Span.Begin)
+	require.Equal(t, int64(1<<40+1<<30-1), wide.items[wide.upper()].Span.End)
+	require.Zero(t, wide.negInf.Quantity)
+	require.Zero(t, wide.posInf.Quantity)
+
+	exponential, err := NewExpandableExponential[uint64](1, 10, 2, 1<<30)
+	require.NoError(t, err)
+
+	exponential.Inc(math.MaxUint64)
+
+	require.Equal(t, 1, exponential.Growths())
+	require.Len(t, exponential.items, 64)
+	require.Zero(t, exponential.posInf.Quantity)
+}
+
+func TestExpandableLayout(t *testing.T) {
+	st, err := NewExpandableLinear(1, 20, 10, 10)
+	require.NoError(t, err)
+
+	copied := st.layout()
+
+	copied.Inc(100)
+
+	require.Zero(t, copied.Growths())
+	require.Equal(t, uint64(1), copied.posInf.Quantity)
+}
+
+func TestExpandableError(t *testing.T) {
+	_, err := NewExpandableLinear(1, 20, 10, -1)
+	require.ErrorIs(t, err, ErrLimitNegative)
+
+	_, err = NewExpandableLinear(1, 20, 10, 0)
+	require.ErrorIs(t, err, ErrLimitZero)
+
+	_, err = NewExpandableLinear(20, 1, 10, 1)
+	require.ErrorIs(t, err, ErrLowerGreaterUpper)
+
+	_, err = NewExpandableExponential(1, 20, 2, -1)
+	require.ErrorIs(t, err, ErrLimitNegative)
+
+	_, err = NewExpandableExponential(1, 20, 2, 0)
+	require.ErrorIs(t, err, ErrLimitZero)
+
+	_, err = NewExpandableExponential(1, 20, 1, 1)
+	require.ErrorIs(t, err, ErrFactorTooSmall)
+}
@@ -0,0 +1,44 @@
+package stat
+
+import (
+	"github.com/akramarenkov/safe"
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+// Creates an exponential statistics whose items have widths increasing by the
+// specified factor.
+//
+// The first item begins with the lower value, each next item begins with the
+// beginning of the previous item multiplied by the factor. The last item is
+// truncated by the upper value. For example, for lower value 1, upper value 100
+// and factor 2 spans are [1:1], [2:3], [4:7], ..., [64:100].
+func NewExponential[Type constraints.Integer](lower, upper, factor Type) (*Stat[Type], error) {
+	if lower > upper {
+		return nil, ErrLowerGreaterUpper
+	}
+
+	if lower <= 0 {
+		return nil, ErrLowerNotPositive
+	}
+
+	if factor < 2 {
+		return nil, ErrFactorTooSmall
+	}
+
+	spans := make([]span.Span[Type], 0)
+
+	for begin := lower; ; {
+		next, err := safe.Mul(begin, factor)
+		if err != nil || next-1 >= upper {
+			spans = append(spans, span.Span[Type]{Begin: begin, End: upper})
+			break
+		}
+
+		spans = append(spans, span.Span[Type]{Begin: begin, End: next - 1})
+
+		begin = next
+	}
+
+	return New(spans, nil)
+}
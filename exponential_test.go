@@ -0,0 +1,70 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExponential(t *testing.T) {
+	stat, err := NewExponential(1, 100, 2)
+	require.NoError(t, err)
+
+	expected := []span.Span[int]{
+		{Begin: 1, End: 1},
+		{Begin: 2, End: 3},
+		{Begin: 4, End: 7},
+		{Begin: 8, End: 15},
+		{Begin: 16, End: 31},
+		{Begin: 32, End: 63},
+		{Begin: 64, End: 100},
+	}
+
+	spans := make([]span.Span[int], 0, len(stat.items))
+
+	for _, item := range stat.items {
+		spans = append(spans, item.Span)
+	}
+
+	require.Equal(t, expected, spans)
+
+	stat.Inc(5)
+	stat.Inc(100)
+	stat.Inc(101)
+
+	require.Equal(t, uint64(1), stat.items[2].Quantity)
+	require.Equal(t, uint64(1), stat.items[6].Quantity)
+	require.Equal(t, uint64(1), stat.posInf.Quantity)
+}
+
+func TestNewExponentialBoundaries(t *testing.T) {
+	stat, err := NewExponential[uint8](10, 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, []Item[uint8]{{Kind: ItemKindRegular, Span: span.Span[uint8]{Begin: 10, End: 10}}}, stat.items)
+
+	stat, err = NewExponential[uint8](100, math.MaxUint8, 3)
+	require.NoError(t, err)
+	require.Equal(t, []Item[uint8]{{Kind: ItemKindRegular, Span: span.Span[uint8]{Begin: 100, End: 255}}}, stat.items)
+
+	signed, err := NewExponential[int8](10, math.MaxInt8, 10)
+	require.NoError(t, err)
+	require.Len(t, signed.items, 2)
+	require.Equal(t, span.Span[int8]{Begin: 100, End: math.MaxInt8}, signed.items[1].Span)
+
+	wide, err := NewExponential[uint64](1, math.MaxUint64, 2)
+	require.NoError(t, err)
+	require.Len(t, wide.items, 64)
+}
+
+func TestNewExponentialError(t *testing.T) {
+	_, err := NewExponential(2, 1, 2)
+	require.ErrorIs(t, err, ErrLowerGreaterUpper)
+
+	_, err = NewExponential(0, 1, 2)
+	require.ErrorIs(t, err, ErrLowerNotPositive)
+
+	_, err = NewExponential(1, 10, 1)
+	require.ErrorIs(t, err, ErrFactorTooSmall)
+}
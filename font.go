@@ -0,0 +1,83 @@
+package stat
+
+// Returns the rows of the glyph of the embedded bitmap font for the specified
+// character, the most significant of the used bits of a row corresponds to the
+// leftmost pixel.
+//
+// For characters missing in the font, an empty glyph is returned.
+func glyph(char rune) [glyphHeight]uint8 {
+	switch char {
+	case '0':
+		return [glyphHeight]uint8{0b111, 0b101, 0b101, 0b101, 0b111}
+	case '1':
+		return [glyphHeight]uint8{0b010, 0b110, 0b010, 0b010, 0b111}
+	case '2':
+		return [glyphHeight]uint8{0b111, 0b001, 0b111, 0b100, 0b111}
+	case '3':
+		return [glyphHeight]uint8{0b111, 0b001, 0b111, 0b001, 0b111}
+	case '4':
+		return [glyphHeight]uint8{0b101, 0b101, 0b111, 0b001, 0b001}
+	case '5':
+		return [glyphHeight]uint8{0b111, 0b100, 0b111, 0b001, 0b111}
+	case '6':
+		return [glyphHeight]uint8{0b111, 0b100, 0b111, 0b101, 0b111}
+	case '7':
+		return [glyphHeight]uint8{0b111, 0b001, 0b001, 0b001, 0b001}
+	case '8':
+		return [glyphHeight]uint8{0b111, 0b101, 0b111, 0b101, 0b111}
+	case '9':
+		return [glyphHeight]uint8{0b111, 0b101, 0b111, 0b001, 0b111}
+	case '[':
+		return [glyphHeight]uint8{0b110, 0b100, 0b100, 0b100, 0b110}
+	case ']':
+		return [glyphHeight]uint8{0b011, 0b001, 0b001, 0b001, 0b011}
+	case ':':
+		return [glyphHeight]uint8{0b000, 0b010, 0b000, 0b010, 0b000}
+	case '-':
+		return [glyphHeight]uint8{0b000, 0b000, 0b111, 0b000, 0b000}
+	case '+':
+		return [glyphHeight]uint8{0b000, 0b010, 0b111, 0b010, 0b000}
+	case '.':
+		return [glyphHeight]uint8{0b000, 0b000, 0b000, 0b000, 0b010}
+	case '%':
+		return [glyphHeight]uint8{0b101, 0b001, 0b010, 0b100, 0b101}
+	case 'I':
+		return [glyphHeight]uint8{0b111, 0b010, 0b010, 0b010, 0b111}
+	case 'L':
+		return [glyphHeight]uint8{0b100, 0b100, 0b100, 0b100, 0b111}
+	case 'a':
+		return [glyphHeight]uint8{0b000, 0b011, 0b101, 0b101, 0b011}
+	case 'c':
+		return [glyphHeight]uint8{0b000, 0b011, 0b100, 0b100, 0b011}
+	case 'd':
+		return [glyphHeight]uint8{0b001, 0b011, 0b101, 0b101, 0b011}
+	case 'e':
+		return [glyphHeight]uint8{0b000, 0b010, 0b111, 0b100, 0b011}
+	case 'f':
+		return [glyphHeight]uint8{0b011, 0b010, 0b111, 0b010, 0b010}
+	case 'g':
+		return [glyphHeight]uint8{0b011, 0b100, 0b101, 0b101, 0b011}
+	case 'h':
+		return [glyphHeight]uint8{0b100, 0b100, 0b111, 0b101, 0b101}
+	case 'i':
+		return [glyphHeight]uint8{0b010, 0b000, 0b010, 0b010, 0b010}
+	case 'l':
+		return [glyphHeight]uint8{0b110, 0b010, 0b010, 0b010, 0b111}
+	case 'm':
+		return [glyphHeight]uint8{0b000, 0b000, 0b111, 0b111, 0b101}
+	case 'n':
+		return [glyphHeight]uint8{0b000, 0b000, 0b110, 0b101, 0b101}
+	case 'o':
+		return [glyphHeight]uint8{0b000, 0b000, 0b111, 0b101, 0b111}
+	case 'p':
+		return [glyphHeight]uint8{0b000, 0b110, 0b101, 0b110, 0b100}
+	case 'r':
+		return [glyphHeight]uint8{0b000, 0b000, 0b101, 0b110, 0b100}
+	case 's':
+		return [glyphHeight]uint8{0b000, 0b011, 0b110, 0b001, 0b110}
+	case 't':
+		return [glyphHeight]uint8{0b010, 0b111, 0b010, 0b010, 0b011}
+	}
+
+	return [glyphHeight]uint8{}
+}
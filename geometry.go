@@ -0,0 +1,78 @@
+package stat
+
+import "golang.org/x/exp/constraints"
+
+// Geometry of a bar chart image.
+type geometry struct {
+	width  float64
+	height float64
+
+	left   float64
+	right  float64
+	top    float64
+	bottom float64
+
+	quantity int
+	maximum  float64
+}
+
+func newGeometry[Type constraints.Integer](chr chart[Type]) geometry {
+	geo := geometry{
+		width:    float64(chr.opts.imageWidth),
+		height:   float64(chr.opts.imageHeight),
+		left:     imageMarginLeft,
+		right:    imageMarginRight,
+		top:      imageMarginTop,
+		bottom:   imageMarginBottom,
+		quantity: len(chr.entries),
+		maximum:  chr.maxMagnitude(),
+	}
+
+	return geo
+}
+
+func (geo geometry) plotWidth() float64 {
+	return max(0, geo.width-geo.left-geo.right)
+}
+
+func (geo geometry) plotHeight() float64 {
+	return max(0, geo.height-geo.top-geo.bottom)
+}
+
+// Returns the vertical coordinate of the horizontal axis.
+func (geo geometry) base() float64 {
+	return geo.top + geo.plotHeight()
+}
+
+// Returns the width of the space allocated for one bar.
+func (geo geometry) slot() float64 {
+	if geo.quantity == 0 {
+		return 0
+	}
+
+	return geo.plotWidth() / float64(geo.quantity)
+}
+
+// Returns the horizontal coordinate and the width of a bar.
+func (geo geometry) bar(id int) (float64, float64) {
+	slot := geo.slot()
+
+	return geo.left + float64(id)*slot + slot*imageBarGap, slot * (1 - 2*imageBarGap)
+}
+
+// Returns the horizontal coordinate of a position specified as a fraction of a
+// bar.
+func (geo geometry) position(id int, fraction float64) float64 {
+	x, width := geo.bar(id)
+
+	return x + fraction*width
+}
+
+// Returns the height of a bar.
+func (geo geometry) barHeight(magnitude float64) float64 {
+	if geo.maximum == 0 {
+		return 0
+	}
+
+	return magnitude / geo.maximum * geo.plotHeight()
+}
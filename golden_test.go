@@ -0,0 +1,28 @@
+package stat
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Compares the actual output with the content of the golden file from the
+// testdata directory.
+//
+// If the STAT_UPDATE_GOLDEN environment variable is set, the golden file is
+// overwritten with the actual output.
+func requireGolden(t *testing.T, name string, actual string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+
+	if os.Getenv("STAT_UPDATE_GOLDEN") != "" {
+		require.NoError(t, os.WriteFile(path, []byte(actual), 0o600))
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), actual)
+}
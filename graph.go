@@ -0,0 +1,435 @@
+package stat
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Option of the statistics display.
+type GraphOption func(opts *graphOpts)
+
+type graphOpts struct {
+	ascii       bool
+	collapse    bool
+	cumulative  bool
+	height      int
+	imageHeight int
+	imageWidth  int
+	labeler     any
+	logarithmic bool
+	palette     Palette
+	percentage  bool
+	quantiles   []float64
+	top         int
+	width       int
+}
+
+// Enables display of the percentage of the total quantity of occurrences next to
+// each item.
+func WithPercentage() GraphOption {
+	return func(opts *graphOpts) {
+		opts.percentage = true
+	}
+}
+
+// Enables display of the cumulative quantity of occurrences (cumulative
+// distribution function) instead of the quantity of occurrences of each item.
+//
+// Cumulative quantity is calculated over all items, before they are limited by
+// the [WithTop] option.
+func WithCumulative() GraphOption {
+	return func(opts *graphOpts) {
+		opts.cumulative = true
+	}
+}
+
+// Enables logarithmic scale of bars.
+//
+// Bar lengths are proportional to the logarithm of the quantity of occurrences
+// increased by one, so that items with a single occurrence remain visible. The
+// displayed quantity of occurrences remains exact.
+func WithLogarithmic() GraphOption {
+	return func(opts *graphOpts) {
+		opts.logarithmic = true
+	}
+}
+
+// Enables collapsing of each run of empty regular items into one item.
+func WithCollapse() GraphOption {
+	return func(opts *graphOpts) {
+		opts.collapse = true
+	}
+}
+
+// Limits display to the specified quantity of items with the largest quantity of
+// occurrences.
+//
+// Items are displayed in their original order. Zero value means no limit.
+func WithTop(quantity int) GraphOption {
+	return func(opts *graphOpts) {
+		opts.top = quantity
+	}
+}
+
+// Sets the maximum length of bars in characters for text renderers.
+//
+// Zero value means the default length.
+func WithWidth(width int) GraphOption {
+	return func(opts *graphOpts) {
+		opts.width = width
+	}
+}
+
+// Sets the maximum height of columns in lines for the column chart.
+//
+// Zero value means the default height.
+func WithHeight(height int) GraphOption {
+	return func(opts *graphOpts) {
+		opts.height = height
+	}
+}
+
+// Restricts text renderers to use only ASCII characters.
+func WithASCII() GraphOption {
+	return func(opts *graphOpts) {
+		opts.ascii = true
+	}
+}
+
+// Sets the size of images in pixels for image renderers.
+//
+// Zero value of width or height means the default value.
+func WithImageSize(width, height int) GraphOption {
+	return func(opts *graphOpts) {
+		opts.imageWidth = width
+		opts.imageHeight = height
+	}
+}
+
+// Enables display of markers of the specified quantiles for image renderers.
+//
+// Each quantile must be in the range [0, 1].
+func WithQuantiles(quantiles ...float64) GraphOption {
+	return func(opts *graphOpts) {
+		opts.quantiles = append(opts.quantiles, quantiles...)
+	}
+}
+
+// Sets the formatter of item labels.
+//
+// Type of items must match the type of statistics values, otherwise displaying
+// will fail with the [ErrLabelFormatterMismatch] error. Labels of collapsed runs
+// of empty items are not formatted.
+func WithLabelFormatter[Type constraints.Integer](formatter func(item Item[Type]) string) GraphOption {
+	return func(opts *graphOpts) {
+		opts.labeler = formatter
+	}
+}
+
+func newGraphOpts(options []GraphOption) (graphOpts, error) {
+	opts := graphOpts{}
+
+	for _, option := range options {
+		option(&opts)
+	}
+
+	if opts.top < 0 {
+		return graphOpts{}, ErrTopNegative
+	}
+
+	if opts.width < 0 {
+		return graphOpts{}, ErrWidthNegative
+	}
+
+	if opts.height < 0 {
+		return graphOpts{}, ErrHeightNegative
+	}
+
+	if opts.imageWidth < 0 {
+		return graphOpts{}, ErrWidthNegative
+	}
+
+	if opts.imageHeight < 0 {
+		return graphOpts{}, ErrHeightNegative
+	}
+
+	for _, quantile := range opts.quantiles {
+		if !isQuantileValid(quantile) {
+			return graphOpts{}, ErrQuantileInvalid
+		}
+	}
+
+	if opts.width == 0 {
+		opts.width = defaultWidth
+	}
+
+	if opts.height == 0 {
+		opts.height = defaultHeight
+	}
+
+	if opts.imageWidth == 0 {
+		opts.imageWidth = defaultImageWidth
+	}
+
+	if opts.imageHeight == 0 {
+		opts.imageHeight = defaultImageHeight
+	}
+
+	opts.palette = opts.palette.complete()
+
+	return opts, nil
+}
+
+// Item of statistics prepared for display.
+type entry[Type constraints.Integer] struct {
+	// Displayed item. For collapsed run of empty items it is the last item of run
+	item Item[Type]
+
+	// Displayed quantity of occurrences (own or cumulative)
+	quantity uint64
+
+	// Index of displayed item in the list of statistics items
+	id int
+
+	// Entry replaces a run of empty regular items
+	collapsed bool
+}
+
+// Marker of a quantile.
+type marker struct {
+	// Quantile value
+	quantile float64
+
+	// Index of the entry containing the quantile
+	id int
+
+	// Position of the quantile within the entry as a fraction of its quantity
+	fraction float64
+}
+
+// Statistics items prepared for display.
+type chart[Type constraints.Integer] struct {
+	entries []entry[Type]
+	labeler func(item Item[Type]) string
+	markers []marker
+	opts    graphOpts
+	total   float64
+}
+
+func newChart[Type constraints.Integer](items []Item[Type], options []GraphOption) (chart[Type], error) {
+	opts, err := newGraphOpts(options)
+	if err != nil {
+		return chart[Type]{}, err
+	}
+
+	chr := chart[Type]{
+		entries: make([]entry[Type], 0, len(items)),
+		labeler: itemLabel[Type],
+		opts:    opts,
+	}
+
+	if opts.labeler != nil {
+		labeler, ok := opts.labeler.(func(item Item[Type]) string)
+		if !ok {
+			return chart[Type]{}, ErrLabelFormatterMismatch
+		}
+
+		chr.labeler = labeler
+	}
+
+	cumulative := uint64(0)
+
+	for id, item := range items {
+		chr.total += float64(item.Quantity)
+
+		quantity := item.Quantity
+
+		if opts.cumulative {
+			cumulative = addSat(cumulative, item.Quantity)
+			quantity = cumulative
+		}
+
+		chr.entries = append(chr.entries, entry[Type]{item: item, quantity: quantity, id: id})
+	}
+
+	chr.limit()
+
+	if opts.collapse {
+		chr.collapse()
+	}
+
+	chr.mark(items)
+
+	return chr, nil
+}
+
+// Places markers of quantiles on entries. Quantiles that are located in items
+// not displayed are skipped.
+func (chr *chart[Type]) mark(items []Item[Type]) {
+	for _, quantile := range chr.opts.quantiles {
+		id, fraction, err := locateQuantile(items, quantile)
+		if err != nil {
+			return
+		}
+
+		for position, ntr := range chr.entries {
+			if ntr.id == id && !ntr.collapsed {
+				mrk := marker{
+					quantile: quantile,
+					id:       position,
+					fraction: fraction,
+				}
+
+				chr.markers = append(chr.markers, mrk)
+
+				break
+			}
+		}
+	}
+}
+
+func (chr *chart[Type]) limit() {
+	if chr.opts.top == 0 || chr.opts.top >= len(chr.entries) {
+		return
+	}
+
+	ids := make([]int, len(chr.entries))
+
+	for id := range ids {
+		ids[id] = id
+	}
+
+	// Stable sorting keeps the original order of items with equal quantity
+	slices.SortStableFunc(ids, func(first, second int) int {
+		return cmp.Compare(chr.entries[second].item.Quantity, chr.entries[first].item.Quantity)
+	})
+
+	ids = ids[:chr.opts.top]
+
+	slices.Sort(ids)
+
+	limited := make([]entry[Type], 0, len(ids))
+
+	for _, id := range ids {
+		limited = append(limited, chr.entries[id])
+	}
+
+	chr.entries = limited
+}
+
+func (chr *chart[Type]) collapse() {
+	collapsed := make([]entry[Type], 0, len(chr.entries))
+
+	for _, ntr := range chr.entries {
+		if ntr.item.Kind != ItemKindRegular || ntr.item.Quantity != 0 {
+			collapsed = append(collapsed, ntr)
+			continue
+		}
+
+		ntr.collapsed = true
+
+		if last := len(collapsed) - 1; last >= 0 && collapsed[last].collapsed {
+			collapsed[last] = ntr
+			continue
+		}
+
+		collapsed = append(collapsed, ntr)
+	}
+
+	chr.entries = collapsed
+}
+
+func (chr chart[Type]) name(ntr entry[Type]) string {
+	if !ntr.collapsed {
+		return chr.labeler(ntr.item)
+	}
+
+	if chr.opts.ascii {
+		return asciiCollapsedLabel
+	}
+
+	return collapsedLabel
+}
+
+func (chr chart[Type]) label(ntr entry[Type], quantity bool) string {
+	label := chr.name(ntr)
+
+	if quantity {
+		label += " " + strconv.FormatUint(ntr.quantity, decimalBase)
+	}
+
+	if chr.opts.percentage {
+		label += " " + formatPercentage(chr.percentage(ntr))
+	}
+
+	return label
+}
+
+func (chr chart[Type]) percentage(ntr entry[Type]) float64 {
+	return chr.percentageOf(ntr.quantity)
+}
+
+func (chr chart[Type]) percentageOf(quantity uint64) float64 {
+	return percentageOf(quantity, chr.total)
+}
+
+// Returns the value determining the length of the bar of an entry.
+func (chr chart[Type]) magnitude(ntr entry[Type]) float64 {
+	if chr.opts.logarithmic {
+		return math.Log1p(float64(ntr.quantity))
+	}
+
+	return float64(ntr.quantity)
+}
+
+// Returns the maximum displayed quantity of occurrences.
+func (chr chart[Type]) maxQuantity() uint64 {
+	maximum := uint64(0)
+
+	for _, ntr := range chr.entries {
+		maximum = max(maximum, ntr.quantity)
+	}
+
+	return maximum
+}
+
+// Returns the maximum value determining the length of the bars.
+func (chr chart[Type]) maxMagnitude() float64 {
+	maximum := 0.0
+
+	for _, ntr := range chr.entries {
+		maximum = max(maximum, chr.magnitude(ntr))
+	}
+
+	return maximum
+}
+
+func itemLabel[Type constraints.Integer](item Item[Type]) string {
+	switch item.Kind {
+	case ItemKindMissed:
+		return fmt.Sprintf("[%v]", item.Kind)
+	case ItemKindNegInf:
+		return fmt.Sprintf("[%v:%v]", item.Kind, item.Span.End)
+	case ItemKindPosInf:
+		return fmt.Sprintf("[%v:%v]", item.Span.Begin, item.Kind)
+	}
+
+	return fmt.Sprintf("[%v:%v]", item.Span.Begin, item.Span.End)
+}
+
+func formatPercentage(percentage float64) string {
+	return fmt.Sprintf("%.2f%%", percentage)
+}
+
+func addSat(first, second uint64) uint64 {
+	if first > math.MaxUint64-second {
+		return math.MaxUint64
+	}
+
+	return first + second
+}
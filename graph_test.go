@@ -0,0 +1,269 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newGraphTestStat(t *testing.T) *Stat[int] {
+	t.Helper()
+
+	return newGraphTestStatOf[int](t)
+}
+
+// Creates the same statistics as newGraphTestStat but with the specified type of
+// values, for example, to make the bounds of the -Inf and +Inf items independent
+// of the platform.
+func newGraphTestStatOf[Type int | int64](t *testing.T) *Stat[Type] {
+	t.Helper()
+
+	stat, err := NewLinear[Type](1, 60, 10)
+	require.NoError(t, err)
+
+	stat.Inc(-1)
+
+	stat.Inc(1)
+	stat.Inc(2)
+	stat.Inc(3)
+
+	stat.Inc(41)
+	stat.Inc(42)
+
+	stat.Inc(51)
+	stat.Inc(52)
+	stat.Inc(53)
+	stat.Inc(54)
+
+	return stat
+}
+
+func chartQuantities(chr chart[int]) []uint64 {
+	quantities := make([]uint64, 0, len(chr.entries))
+
+	for _, ntr := range chr.entries {
+		quantities = append(quantities, ntr.quantity)
+	}
+
+	return quantities
+}
+
+func chartLabels(chr chart[int]) []string {
+	labels := make([]string, 0, len(chr.entries))
+
+	for _, ntr := range chr.entries {
+		labels = append(labels, chr.label(ntr, false))
+	}
+
+	return labels
+}
+
+func TestGraphDefault(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	chr, err := newChart(stat.Items(), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 3, 0, 0, 0, 2, 4}, chartQuantities(chr))
+	require.Equal(
+		t,
+		[]string{
+			"[-Inf:0]",
+			"[1:10]",
+			"[11:20]",
+			"[21:30]",
+			"[31:40]",
+			"[41:50]",
+			"[51:60]",
+		},
+		chartLabels(chr),
+	)
+	require.NoError(t, stat.Graph(io.Discard))
+}
+
+func TestGraphPercentage(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithPercentage()})
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]string{
+			"[-Inf:0] 10.00%",
+			"[1:10] 30.00%",
+			"[11:20] 0.00%",
+			"[21:30] 0.00%",
+			"[31:40] 0.00%",
+			"[41:50] 20.00%",
+			"[51:60] 40.00%",
+		},
+		chartLabels(chr),
+	)
+	require.NoError(t, stat.GraphWith(io.Discard, WithPercentage()))
+}
+
+func TestGraphPercentageEmpty(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithPercentage()})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"[1:10] 0.00%", "[11:20] 0.00%"}, chartLabels(chr))
+}
+
+func TestGraphCumulative(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	chr, err := newChart(
+		stat.Items(),
+		[]GraphOption{WithCumulative(), WithPercentage()},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 4, 4, 4, 4, 6, 10}, chartQuantities(chr))
+	require.Equal(
+		t,
+		[]string{
+			"[-Inf:0] 10.00%",
+			"[1:10] 40.00%",
+			"[11:20] 40.00%",
+			"[21:30] 40.00%",
+			"[31:40] 40.00%",
+			"[41:50] 60.00%",
+			"[51:60] 100.00%",
+		},
+		chartLabels(chr),
+	)
+	require.NoError(t, stat.GraphWith(io.Discard, WithCumulative()))
+}
+
+func TestGraphCumulativeSaturation(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	stat.items[0].Quantity = math.MaxUint64
+	stat.items[1].Quantity = 1
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithCumulative()})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64}, chartQuantities(chr))
+}
+
+func TestGraphCollapse(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithCollapse()})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 3, 0, 2, 4}, chartQuantities(chr))
+	require.Equal(
+		t,
+		[]string{"[-Inf:0]", "[1:10]", "…", "[41:50]", "[51:60]"},
+		chartLabels(chr),
+	)
+
+	chr, err = newChart(
+		stat.Items(),
+		[]GraphOption{WithCollapse(), WithCumulative()},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 4, 4, 6, 10}, chartQuantities(chr))
+	require.NoError(t, stat.GraphWith(io.Discard, WithCollapse()))
+}
+
+func TestGraphTop(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithTop(2)})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{3, 4}, chartQuantities(chr))
+	require.Equal(t, []string{"[1:10]", "[51:60]"}, chartLabels(chr))
+
+	chr, err = newChart(stat.Items(), []GraphOption{WithTop(5)})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 3, 0, 2, 4}, chartQuantities(chr))
+	require.Equal(
+		t,
+		[]string{"[-Inf:0]", "[1:10]", "[11:20]", "[41:50]", "[51:60]"},
+		chartLabels(chr),
+	)
+
+	chr, err = newChart(stat.Items(), []GraphOption{WithTop(6), WithCollapse()})
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 3, 0, 2, 4}, chartQuantities(chr))
+	require.Equal(
+		t,
+		[]string{"[-Inf:0]", "[1:10]", "…", "[41:50]", "[51:60]"},
+		chartLabels(chr),
+	)
+
+	chr, err = newChart(stat.Items(), []GraphOption{WithTop(100)})
+	require.NoError(t, err)
+
+	require.Len(t, chr.entries, 7)
+	require.NoError(t, stat.GraphWith(io.Discard, WithTop(2)))
+}
+
+func TestGraphOptionsError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.ErrorIs(t, stat.GraphWith(io.Discard, WithTop(-1)), ErrTopNegative)
+}
+
+func TestGraphLogarithmic(t *testing.T) {
+	stat, err := NewLinear(1, 30, 10)
+	require.NoError(t, err)
+
+	stat.items[0].Quantity = 1e6
+	stat.items[1].Quantity = 3
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithLogarithmic()})
+	require.NoError(t, err)
+
+	require.InDelta(t, math.Log1p(1e6), chr.maxMagnitude(), 1e-9)
+	require.InDelta(t, math.Log1p(3), chr.magnitude(chr.entries[1]), 1e-9)
+	require.Zero(t, chr.magnitude(chr.entries[2]))
+	require.Equal(
+		t,
+		[]string{"[1:10] 1000000", "[11:20] 3", "[21:30] 0"},
+		[]string{
+			chr.label(chr.entries[0], true),
+			chr.label(chr.entries[1], true),
+			chr.label(chr.entries[2], true),
+		},
+	)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.GraphWith(buffer, WithLogarithmic()))
+	require.True(t, strings.HasPrefix(buffer.String(), logarithmicMarker))
+}
+
+func TestGraphLabelFormatter(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	formatter := func(item Item[int]) string {
+		return item.Kind.String()
+	}
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithLabelFormatter(formatter), WithCollapse()})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{"-Inf", "regular", "…", "regular", "regular"},
+		chartLabels(chr),
+	)
+
+	_, err = newChart(stat.Items(), []GraphOption{WithLabelFormatter(func(Item[int8]) string { return "" })})
+	require.ErrorIs(t, err, ErrLabelFormatterMismatch)
+}
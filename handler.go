@@ -0,0 +1,350 @@
+package stat
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HTTP handler serving statistics.
+//
+// Statistics are selected by the name query parameter, which can be specified
+// several times, if it is not specified, all registered statistics are served.
+//
+// Format of response is selected by the format query parameter (text, json,
+// prometheus or html) or, if it is not specified, by the Accept header. Text
+// format is the output of [Stat.Text], Prometheus format is the output of
+// [Stat.WritePrometheus] with names of statistics converted to valid metric
+// names, HTML format is the output of [Stat.WriteHTML].
+//
+// Display options are specified by query parameters:
+//   - log, collapse, cumulative, percentage, ascii - boolean values that enable
+//     [WithLogarithmic], [WithCollapse], [WithCumulative], [WithPercentage] and
+//     [WithASCII] options respectively;
+//   - width, top - integer values of [WithWidth] and [WithTop] options
+//     respectively;
+//   - quantile - quantile for [WithQuantiles] option, can be specified several
+//     times.
+//
+// Display options are applied to the text and HTML formats, except width, which
+// is applied to the text format only, and quantile, which is applied to the HTML
+// format only.
+type Handler[Type constraints.Integer] struct {
+	mutex sync.RWMutex
+	names []string
+	stats map[string]*Concurrent[Type]
+}
+
+// Creates an HTTP handler serving statistics.
+func NewHandler[Type constraints.Integer]() *Handler[Type] {
+	hnd := &Handler[Type]{
+		stats: make(map[string]*Concurrent[Type]),
+	}
+
+	return hnd
+}
+
+// Registers statistics with the specified name.
+//
+// Name must not be converted to the same metric name as the name of already
+// registered statistics, otherwise metrics of the Prometheus format would be
+// indistinguishable.
+func (hnd *Handler[Type]) Register(name string, st *Concurrent[Type]) error {
+	if name == "" {
+		return ErrNameEmpty
+	}
+
+	hnd.mutex.Lock()
+	defer hnd.mutex.Unlock()
+
+	if _, exists := hnd.stats[name]; exists {
+		return ErrNameDuplicated
+	}
+
+	metric := metricName(name)
+
+	for _, registered := range hnd.names {
+		if metricName(registered) == metric {
+			return ErrMetricNameCollision
+		}
+	}
+
+	hnd.names = append(hnd.names, name)
+	hnd.stats[name] = st
+
+	return nil
+}
+
+// Serves statistics.
+func (hnd *Handler[Type]) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		writer.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+		http.Error(writer, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	query := request.URL.Query()
+
+	names, snapshots, found := hnd.snapshots(query["name"])
+	if !found {
+		http.Error(writer, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	opts, err := parseGraphOptions(query)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	format := query.Get("format")
+
+	if format == "" {
+		format = negotiateFormat(request.Header.Get("Accept"))
+	}
+
+	if !slices.Contains([]string{formatHTML, formatJSON, formatPrometheus, formatText}, format) {
+		http.Error(writer, ErrFormatUnknown.Error(), http.StatusBadRequest)
+		return
+	}
+
+	buffer := new(bytes.Buffer)
+
+	contentType, err := renderStats(buffer, format, names, snapshots, opts)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Length", strconv.Itoa(buffer.Len()))
+
+	if request.Method == http.MethodHead {
+		return
+	}
+
+	// Error can only occur if the client has disconnected, there is no one to
+	// report it to
+	_, _ = buffer.WriteTo(writer)
+}
+
+// Returns snapshots of statistics with the specified names or of all statistics
+// if none are specified.
+func (hnd *Handler[Type]) snapshots(names []string) ([]string, []*Stat[Type], bool) {
+	hnd.mutex.RLock()
+	defer hnd.mutex.RUnlock()
+
+	if len(names) == 0 {
+		names = slices.Clone(hnd.names)
+	}
+
+	snapshots := make([]*Stat[Type], len(names))
+
+	for id, name := range names {
+		cnc, exists := hnd.stats[name]
+		if !exists {
+			return nil, nil, false
+		}
+
+		snapshots[id] = cnc.Snapshot()
+	}
+
+	return names, snapshots, true
+}
+
+func parseGraphOptions(query map[string][]string) ([]GraphOption, error) {
+	opts := make([]GraphOption, 0, len(query))
+
+	flags := []struct {
+		name   string
+		option func() GraphOption
+	}{
+		{name: "ascii", option: WithASCII},
+		{name: "collapse", option: WithCollapse},
+		{name: "cumulative", option: WithCumulative},
+		{name: "log", option: WithLogarithmic},
+		{name: "percentage", option: WithPercentage},
+	}
+
+	for _, flag := range flags {
+		values, exists := query[flag.name]
+		if !exists {
+			continue
+		}
+
+		// Parameter without value (e.g. ?log) enables option
+		if values[0] == "" {
+			opts = append(opts, flag.option())
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if enabled {
+			opts = append(opts, flag.option())
+		}
+	}
+
+	numbers := []struct {
+		name   string
+		option func(number int) GraphOption
+	}{
+		{name: "top", option: WithTop},
+		{name: "width", option: WithWidth},
+	}
+
+	for _, number := range numbers {
+		values, exists := query[number.name]
+		if !exists {
+			continue
+		}
+
+		converted, err := strconv.Atoi(values[0])
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, number.option(converted))
+	}
+
+	for _, value := range query["quantile"] {
+		quantile, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, WithQuantiles(quantile))
+	}
+
+	// Options are validated here to distinguish invalid requests from failures
+	if _, err := newGraphOpts(opts); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+// Returns the format of response most preferred by the client according to the
+// value of the Accept header.
+func negotiateFormat(accept string) string {
+	format := formatText
+	preference := 0.0
+
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+
+		media := strings.ToLower(strings.TrimSpace(params[0]))
+		quality := 1.0
+		version := ""
+
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+
+			switch strings.ToLower(key) {
+			case "q":
+				parsed, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					continue
+				}
+
+				quality = parsed
+			case "version":
+				version = value
+			}
+		}
+
+		candidate := ""
+
+		switch media {
+		case "application/json":
+			candidate = formatJSON
+		case "text/html":
+			candidate = formatHTML
+		case "application/openmetrics-text":
+			candidate = formatPrometheus
+		case "text/plain":
+			candidate = formatText
+
+			if version != "" {
+				candidate = formatPrometheus
+			}
+		}
+
+		if candidate != "" && quality > preference {
+			format = candidate
+			preference = quality
+		}
+	}
+
+	return format
+}
+
+// Writes statistics in the specified format and returns the content type.
+func renderStats[Type constraints.Integer](
+	writer io.Writer,
+	format string,
+	names []string,
+	stats []*Stat[Type],
+	opts []GraphOption,
+) (string, error) {
+	switch format {
+	case formatText:
+		for id, st := range stats {
+			if id != 0 {
+				_, _ = io.WriteString(writer, "\n")
+			}
+
+			_, _ = io.WriteString(writer, names[id]+"\n")
+
+			if err := st.Text(writer, opts...); err != nil {
+				return "", err
+			}
+		}
+
+		return "text/plain; charset=utf-8", nil
+	case formatJSON:
+		encoded := make(map[string]jsonStat[Type], len(stats))
+
+		for id, st := range stats {
+			encoded[names[id]] = newJSONStat(st.Items())
+		}
+
+		return "application/json", json.NewEncoder(writer).Encode(encoded)
+	case formatPrometheus:
+		for id, st := range stats {
+			_, _ = io.WriteString(writer, prometheus(metricName(names[id]), st.bins()))
+		}
+
+		return "text/plain; version=0.0.4; charset=utf-8", nil
+	case formatHTML:
+		reports := make([]htmlReport, len(stats))
+
+		for id, st := range stats {
+			items := st.Items()
+
+			chr, err := newChart(items, opts)
+			if err != nil {
+				return "", err
+			}
+
+			if reports[id], err = newHTMLReport(names[id], items, chr); err != nil {
+				return "", err
+			}
+		}
+
+		return "text/html; charset=utf-8", writeHTML(writer, reports)
+	}
+
+	return "", ErrFormatUnknown
+}
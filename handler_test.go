@@ -0,0 +1,162 @@
+package stat
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newHandlerTest(t *testing.T) *Handler[int] {
+	t.Helper()
+
+	first, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	second, err := NewLinear(1, 40, 10)
+	require.NoError(t, err)
+
+	firstCnc := NewConcurrent(first)
+	secondCnc := NewConcurrent(second)
+
+	hnd := NewHandler[int]()
+
+	require.NoError(t, hnd.Register("first", firstCnc))
+	require.NoError(t, hnd.Register("second latency", secondCnc))
+
+	firstCnc.Inc(1)
+	firstCnc.Inc(15)
+	secondCnc.Inc(35)
+
+	return hnd
+}
+
+func serveHandlerTest(hnd http.Handler, method string, target string, accept string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, target, nil)
+
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+
+	recorder := httptest.NewRecorder()
+
+	hnd.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func TestHandlerText(t *testing.T) {
+	hnd := newHandlerTest(t)
+
+	expected := new(strings.Builder)
+	expected.WriteString("first\n")
+
+	require.NoError(t, hnd.stats["first"].Snapshot().Text(expected, WithASCII(), WithPercentage(), WithWidth(10)))
+
+	response := serveHandlerTest(hnd, http.MethodGet, "/?name=first&ascii&percentage=true&width=10", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "text/plain; charset=utf-8", response.Header().Get("Content-Type"))
+	require.Equal(t, expected.String(), response.Body.String())
+	require.Contains(t, response.Body.String(), asciiBlock)
+	require.NotContains(t, response.Body.String(), "\x1b")
+
+	narrow := serveHandlerTest(hnd, http.MethodGet, "/?name=first&ascii&percentage=true&width=3", "")
+	require.Equal(t, http.StatusOK, narrow.Code)
+	require.NotEqual(t, response.Body.String(), narrow.Body.String())
+
+	response = serveHandlerTest(hnd, http.MethodGet, "/?log=1&collapse=true", "text/plain")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.True(t, strings.HasPrefix(response.Body.String(), "first\n"+logarithmicMarker+"\n"))
+	require.Contains(t, response.Body.String(), "\n\nsecond latency\n"+logarithmicMarker+"\n")
+	require.NotContains(t, response.Body.String(), "\x1b")
+
+	response = serveHandlerTest(hnd, http.MethodHead, "/", "")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.NotEqual(t, "0", response.Header().Get("Content-Length"))
+	require.Empty(t, response.Body.String())
+}
+
+func TestHandlerJSON(t *testing.T) {
+	hnd := newHandlerTest(t)
+
+	response := serveHandlerTest(hnd, http.MethodGet, "/", "text/html;q=0.5, application/json")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "application/json", response.Header().Get("Content-Type"))
+
+	decoded := make(map[string]jsonStat[int])
+
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &decoded))
+	require.Len(t, decoded, 2)
+	require.Equal(t, uint64(2), decoded["first"].Summary.Total)
+	require.Equal(t, uint64(1), decoded["second latency"].Items[3].Quantity)
+}
+
+func TestHandlerPrometheus(t *testing.T) {
+	hnd := newHandlerTest(t)
+
+	accept := "application/openmetrics-text;version=1.0.0;q=0.5,text/plain;version=0.0.4;q=0.4,*/*;q=0.1"
+
+	response := serveHandlerTest(hnd, http.MethodGet, "/", accept)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", response.Header().Get("Content-Type"))
+	require.Contains(t, response.Body.String(), "# TYPE first histogram\n")
+	require.Contains(t, response.Body.String(), "second_latency_count 1\n")
+
+	response = serveHandlerTest(hnd, http.MethodGet, "/?format=prometheus&name=first", "application/json")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.NotContains(t, response.Body.String(), "second_latency")
+}
+
+func TestHandlerHTML(t *testing.T) {
+	hnd := newHandlerTest(t)
+
+	response := serveHandlerTest(hnd, http.MethodGet, "/?quantile=0.5&quantile=0.9", "text/html,application/xhtml+xml")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, "text/html; charset=utf-8", response.Header().Get("Content-Type"))
+	require.Contains(t, response.Body.String(), "<h1>first</h1>")
+	require.Contains(t, response.Body.String(), "<h1>second latency</h1>")
+	require.Contains(t, response.Body.String(), "<tr><th>p90</th>")
+}
+
+func TestHandlerError(t *testing.T) {
+	hnd := newHandlerTest(t)
+
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, hnd.Register("", NewConcurrent(stat)), ErrNameEmpty)
+	require.ErrorIs(t, hnd.Register("first", NewConcurrent(stat)), ErrNameDuplicated)
+	require.ErrorIs(t, hnd.Register("second-latency", NewConcurrent(stat)), ErrMetricNameCollision)
+	require.ErrorIs(t, hnd.Register("second_latency", NewConcurrent(stat)), ErrMetricNameCollision)
+
+	response := serveHandlerTest(hnd, http.MethodPost, "/", "")
+	require.Equal(t, http.StatusMethodNotAllowed, response.Code)
+	require.Equal(t, "GET, HEAD", response.Header().Get("Allow"))
+
+	response = serveHandlerTest(hnd, http.MethodGet, "/?name=third", "")
+	require.Equal(t, http.StatusNotFound, response.Code)
+
+	for _, target := range []string{
+		"/?format=xml",
+		"/?log=maybe",
+		"/?width=wide",
+		"/?width=-1",
+		"/?quantile=median",
+		"/?quantile=2",
+	} {
+		response = serveHandlerTest(hnd, http.MethodGet, target, "")
+		require.Equal(t, http.StatusBadRequest, response.Code, target)
+	}
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	require.Equal(t, formatText, negotiateFormat(""))
+	require.Equal(t, formatText, negotiateFormat("*/*"))
+	require.Equal(t, formatText, negotiateFormat("image/png"))
+	require.Equal(t, formatJSON, negotiateFormat("application/json;q=0.9,text/plain;q=0.8"))
+	require.Equal(t, formatHTML, negotiateFormat("application/json;q=invalid;q=0.1,TEXT/HTML;q=0.2"))
+	require.Equal(t, formatPrometheus, negotiateFormat("text/plain;version=0.0.4"))
+}
@@ -0,0 +1,236 @@
+package stat
+
+import (
+	"html/template"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a self-contained HTML report to the specified writer.
+//
+// Report contains the bar chart (the same as written by [Stat.WriteSVG]) with
+// tooltips showing the spans and quantities of occurrences of items, the summary,
+// the quantiles and the table of items. Report does not refer to any external
+// resources.
+//
+// Quantiles are specified by the [WithQuantiles] option, if it is not specified,
+// the quantiles 0.5, 0.9, 0.99 and 0.999 are displayed in the report but are not
+// marked on the bar chart.
+//
+// If writer is not specified (is nil), the report will be written to standard
+// output.
+func (st *Stat[Type]) WriteHTML(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	items := st.Items()
+
+	chr, err := newChart(items, opts)
+	if err != nil {
+		return err
+	}
+
+	report, err := newHTMLReport("", items, chr)
+	if err != nil {
+		return err
+	}
+
+	return writeHTML(writer, []htmlReport{report})
+}
+
+type htmlReport struct {
+	Name      string
+	Chart     template.HTML
+	Summary   []htmlRow
+	Quantiles []htmlRow
+	Items     []htmlItem
+}
+
+type htmlRow struct {
+	Name  string
+	Value string
+}
+
+type htmlItem struct {
+	Kind       string
+	Begin      string
+	End        string
+	Quantity   uint64
+	Percentage string
+}
+
+func newHTMLReport[Type constraints.Integer](name string, items []Item[Type], chr chart[Type]) (htmlReport, error) {
+	svg := new(strings.Builder)
+
+	if err := writeSVG(svg, chr); err != nil {
+		return htmlReport{}, err
+	}
+
+	summary := summarize(items)
+
+	report := htmlReport{
+		Name: name,
+		// SVG image is generated by this package with escaping of all texts
+		Chart: template.HTML(svg.String()), //nolint:gosec // Trusted content
+		Summary: []htmlRow{
+			{Name: "Total", Value: strconv.FormatUint(summary.Total, decimalBase)},
+			{Name: ItemKindMissed.String(), Value: strconv.FormatUint(summary.Missed, decimalBase)},
+			{Name: ItemKindNegInf.String(), Value: strconv.FormatUint(summary.NegInf, decimalBase)},
+			{Name: ItemKindPosInf.String(), Value: strconv.FormatUint(summary.PosInf, decimalBase)},
+		},
+		Items: make([]htmlItem, 0, len(items)),
+	}
+
+	// Bounds and mean are calculated from regular items only
+	bounds := []htmlRow{
+		{Name: "Minimum", Value: htmlNoValue},
+		{Name: "Maximum", Value: htmlNoValue},
+		{Name: "Mean", Value: htmlNoValue},
+	}
+
+	if hasRegularOccurrences(items) {
+		bounds[0].Value = formatInteger(summary.Min)
+		bounds[1].Value = formatInteger(summary.Max)
+		bounds[2].Value = strconv.FormatFloat(summary.Mean, 'g', -1, 64)
+	}
+
+	report.Summary = append(report.Summary, bounds...)
+
+	quantiles := chr.opts.quantiles
+
+	if len(quantiles) == 0 {
+		quantiles = defaultQuantiles()
+	}
+
+	for _, quantile := range quantiles {
+		row := htmlRow{
+			Name:  quantileLabel(quantile),
+			Value: htmlNoValue,
+		}
+
+		if value, err := quantileValue(items, quantile); err == nil {
+			row.Value = formatInteger(value)
+		}
+
+		report.Quantiles = append(report.Quantiles, row)
+	}
+
+	for _, item := range items {
+		row := htmlItem{
+			Kind:       item.Kind.String(),
+			Begin:      formatInteger(item.Span.Begin),
+			End:        formatInteger(item.Span.End),
+			Quantity:   item.Quantity,
+			Percentage: formatPercentage(chr.percentageOf(item.Quantity)),
+		}
+
+		if item.Kind == ItemKindMissed {
+			row.Begin = htmlNoValue
+			row.End = htmlNoValue
+		}
+
+		report.Items = append(report.Items, row)
+	}
+
+	return report, nil
+}
+
+func writeHTML(writer io.Writer, reports []htmlReport) error {
+	tmpl, err := template.New("reports").Parse(htmlTemplate)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(writer, reports)
+}
+
+func defaultQuantiles() []float64 {
+	return []float64{0.5, 0.9, 0.99, 0.999}
+}
+
+func formatInteger[Type constraints.Integer](number Type) string {
+	if number < 0 {
+		return strconv.FormatInt(int64(number), decimalBase)
+	}
+
+	return strconv.FormatUint(uint64(number), decimalBase)
+}
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Statistics</title>
+<style>
+body{font-family:sans-serif;color:#333;margin:2em}
+table{border-collapse:collapse;margin-bottom:2em}
+th,td{border:1px solid #ccc;padding:0.3em 0.8em;text-align:right}
+th{background:#f4f4f4}
+.chart rect[data-span]:hover{opacity:0.7}
+.tooltip{
+  position:absolute;pointer-events:none;
+  background:#333;color:#fff;padding:0.3em 0.6em;
+  border-radius:3px;font-size:12px;white-space:pre
+}
+</style>
+</head>
+<body>
+{{- range .}}
+<h1>{{if .Name}}{{.Name}}{{else}}Statistics{{end}}</h1>
+<div class="chart">
+{{.Chart}}</div>
+<h2>Summary</h2>
+<table class="summary">
+{{- range .Summary}}
+<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+<h2>Quantiles</h2>
+<table class="quantiles">
+{{- range .Quantiles}}
+<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+<h2>Items</h2>
+<table class="items">
+<tr><th>Kind</th><th>Begin</th><th>End</th><th>Quantity</th><th>Percentage</th></tr>
+{{- range .Items}}
+<tr><td>{{.Kind}}</td><td>{{.Begin}}</td><td>{{.End}}</td><td>{{.Quantity}}</td><td>{{.Percentage}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<div class="tooltip" id="tooltip" hidden></div>
+<script>
+(function () {
+  var tooltip = document.getElementById("tooltip");
+
+  document.querySelectorAll(".chart rect[data-span]").forEach(function (bar) {
+    var title = bar.querySelector("title");
+
+    if (title) {
+      bar.removeChild(title);
+    }
+
+    bar.addEventListener("mousemove", function (event) {
+      tooltip.textContent = "Span: " + bar.dataset.span +
+        "\nQuantity: " + bar.dataset.quantity +
+        "\nPercentage: " + bar.dataset.percentage;
+      tooltip.style.left = (event.pageX + 12) + "px";
+      tooltip.style.top = (event.pageY + 12) + "px";
+      tooltip.hidden = false;
+    });
+
+    bar.addEventListener("mouseleave", function () {
+      tooltip.hidden = true;
+    });
+  });
+})();
+</script>
+</body>
+</html>
+`
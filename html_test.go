@@ -0,0 +1,76 @@
+package stat
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatWriteHTML(t *testing.T) {
+	stat := newGraphTestStatOf[int64](t)
+
+	stat.Inc(100)
+	stat.missed.Quantity = 1
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WriteHTML(buffer, WithImageSize(400, 200)))
+
+	report := buffer.String()
+
+	require.NotContains(t, report, "src=")
+	require.NotContains(t, report, "href=")
+	require.Contains(t, report, "<tr><th>p50</th><td>48</td></tr>")
+	require.Contains(t, report, "<tr><th>p99.9</th><td>61</td></tr>")
+	require.Contains(t, report, "<tr><th>Minimum</th><td>1</td></tr>")
+	require.Contains(t, report, "<tr><th>Maximum</th><td>60</td></tr>")
+	require.Contains(t, report, "<tr><td>missed</td><td>—</td><td>—</td><td>1</td><td>8.33%</td></tr>")
+	requireGolden(t, "stat.html", report)
+}
+
+func TestStatWriteHTMLQuantiles(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WriteHTML(buffer, WithQuantiles(0.1)))
+	require.Contains(t, buffer.String(), "<tr><th>p10</th><td>0</td></tr>")
+	require.NotContains(t, buffer.String(), "<tr><th>p50</th>")
+	require.Contains(t, buffer.String(), `class="quantile"`)
+}
+
+func TestStatWriteHTMLEmpty(t *testing.T) {
+	stat, err := NewLinear[uint64](1, 20, 10)
+	require.NoError(t, err)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WriteHTML(buffer))
+	require.Contains(t, buffer.String(), "<tr><th>p50</th><td>—</td></tr>")
+	require.Contains(t, buffer.String(), "<tr><th>Minimum</th><td>—</td></tr>")
+	require.Contains(t, buffer.String(), "<tr><th>Mean</th><td>—</td></tr>")
+	require.NotContains(t, buffer.String(), "<tr><td>-Inf</td>")
+}
+
+func TestStatWriteHTMLError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.WriteHTML(io.Discard, WithTop(-1)))
+	require.Error(t, stat.WriteHTML((*os.File)(nil)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.WriteHTML(nil))
+
+	os.Stdout = stdout
+}
+
+func TestFormatInteger(t *testing.T) {
+	require.Equal(t, "-128", formatInteger(int8(-128)))
+	require.Equal(t, "0", formatInteger(0))
+	require.Equal(t, "18446744073709551615", formatInteger(uint64(18446744073709551615)))
+}
@@ -0,0 +1,155 @@
+package stat
+
+import (
+	"encoding/json"
+
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+type jsonStat[Type constraints.Integer] struct {
+	Items   []jsonItem[Type]  `json:"items"`
+	Summary jsonSummary[Type] `json:"summary"`
+}
+
+type jsonItem[Type constraints.Integer] struct {
+	Kind     string `json:"kind"`
+	Begin    Type   `json:"begin"`
+	End      Type   `json:"end"`
+	Quantity uint64 `json:"quantity"`
+}
+
+type jsonSummary[Type constraints.Integer] struct {
+	Total  uint64  `json:"total"`
+	Missed uint64  `json:"missed"`
+	NegInf uint64  `json:"negInf"`
+	PosInf uint64  `json:"posInf"`
+	Min    Type    `json:"min"`
+	Max    Type    `json:"max"`
+	Mean   float64 `json:"mean"`
+}
+
+// Returns statistics items and summary encoded in JSON.
+func (st *Stat[Type]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newJSONStat(st.Items()))
+}
+
+// Returns statistics items and summary encoded in JSON.
+//
+// Together with [Stat.MarshalJSON] it makes statistics usable as an
+// [expvar.Var], see also [Publish].
+func (st *Stat[Type]) String() string {
+	data, err := st.MarshalJSON()
+	if err != nil {
+		return "null"
+	}
+
+	return string(data)
+}
+
+func newJSONStat[Type constraints.Integer](items []Item[Type]) jsonStat[Type] {
+	summary := summarize(items)
+
+	encoded := jsonStat[Type]{
+		Items: make([]jsonItem[Type], len(items)),
+		Summary: jsonSummary[Type]{
+			Total:  summary.Total,
+			Missed: summary.Missed,
+			NegInf: summary.NegInf,
+			PosInf: summary.PosInf,
+			Min:    summary.Min,
+			Max:    summary.Max,
+			Mean:   summary.Mean,
+		},
+	}
+
+	for id, item := range items {
+		encoded.Items[id] = jsonItem[Type]{
+			Kind:     item.Kind.String(),
+			Begin:    item.Span.Begin,
+			End:      item.Span.End,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return encoded
+}
+
+// Restores statistics from items encoded in JSON by [Stat.MarshalJSON].
+//
+// Summary is not used. Restored statistics does not have a prediction function.
+func (st *Stat[Type]) UnmarshalJSON(data []byte) error {
+	encoded := jsonStat[Type]{}
+
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	items := make([]Item[Type], len(encoded.Items))
+
+	for id, item := range encoded.Items {
+		kind, err := parseItemKind(item.Kind)
+		if err != nil {
+			return err
+		}
+
+		items[id] = Item[Type]{
+			Kind:     kind,
+			Quantity: item.Quantity,
+			Span:     span.Span[Type]{Begin: item.Begin, End: item.End},
+		}
+	}
+
+	restored, err := restore(items)
+	if err != nil {
+		return err
+	}
+
+	*st = *restored
+
+	return nil
+}
+
+func parseItemKind(kind string) (ItemKind, error) {
+	for _, known := range []ItemKind{ItemKindRegular, ItemKindNegInf, ItemKindPosInf, ItemKindMissed} {
+		if kind == known.String() {
+			return known, nil
+		}
+	}
+
+	return 0, ErrItemKindUnknown
+}
+
+// Creates statistics from the list of items.
+func restore[Type constraints.Integer](items []Item[Type]) (*Stat[Type], error) {
+	spans := make([]span.Span[Type], 0, len(items))
+
+	for _, item := range items {
+		if item.Kind == ItemKindRegular {
+			spans = append(spans, item.Span)
+		}
+	}
+
+	restored, err := New(spans, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	regular := 0
+
+	for _, item := range items {
+		switch item.Kind {
+		case ItemKindMissed:
+			restored.missed.Quantity = item.Quantity
+		case ItemKindNegInf:
+			restored.negInf.Quantity = item.Quantity
+		case ItemKindPosInf:
+			restored.posInf.Quantity = item.Quantity
+		case ItemKindRegular:
+			restored.items[regular].Quantity = item.Quantity
+			regular++
+		}
+	}
+
+	return restored, nil
+}
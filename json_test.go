@@ -0,0 +1,63 @@
+package stat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatString(t *testing.T) {
+	stat, err := NewLinear[int8](1, 20, 10)
+	require.NoError(t, err)
+
+	stat.Inc(-1)
+	stat.Inc(1)
+	stat.Inc(12)
+	stat.Inc(12)
+
+	expected := `{"items":[` +
+		`{"kind":"-Inf","begin":-128,"end":0,"quantity":1},` +
+		`{"kind":"regular","begin":1,"end":10,"quantity":1},` +
+		`{"kind":"regular","begin":11,"end":20,"quantity":2}],` +
+		`"summary":{"total":4,"missed":0,"negInf":1,"posInf":0,"min":1,"max":20,"mean":12.166666666666666}}`
+
+	require.JSONEq(t, expected, stat.String())
+
+	data, err := json.Marshal(stat)
+	require.NoError(t, err)
+	require.JSONEq(t, expected, string(data))
+}
+
+func TestStatUnmarshalJSON(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	stat.Inc(100)
+	stat.missed.Quantity = 2
+
+	data, err := json.Marshal(stat)
+	require.NoError(t, err)
+
+	restored := new(Stat[int])
+
+	require.NoError(t, json.Unmarshal(data, restored))
+	require.Equal(t, stat.Items(), restored.Items())
+
+	restored.Inc(45)
+	require.Equal(t, uint64(3), restored.Items()[6].Quantity)
+}
+
+func TestStatUnmarshalJSONError(t *testing.T) {
+	restored := new(Stat[int])
+
+	require.Error(t, json.Unmarshal([]byte(`{"items":{}}`), restored))
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"items":[{"kind":"other"}]}`), restored), ErrItemKindUnknown)
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"items":[]}`), restored), ErrSpansListEmpty)
+	require.Error(
+		t,
+		json.Unmarshal(
+			[]byte(`{"items":[{"kind":"regular","begin":5,"end":6},{"kind":"regular","begin":1,"end":2}]}`),
+			restored,
+		),
+	)
+}
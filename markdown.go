@@ -0,0 +1,83 @@
+package stat
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a GitHub Flavored Markdown table to the specified writer.
+//
+// Table contains the kind, the label, the quantity of occurrences and the
+// percentage of the total quantity of occurrences of each item and a column
+// with a bar drawn in the same way as by [Stat.Text]. The maximum length of bars
+// is set by the [WithWidth] option.
+//
+// If writer is not specified (is nil), the table will be written to standard
+// output.
+func (st *Stat[Type]) Markdown(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return markdown(writer, chr)
+}
+
+func markdown[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	builder := new(strings.Builder)
+
+	if chr.opts.logarithmic {
+		builder.WriteString(logarithmicMarker)
+		builder.WriteString("\n\n")
+	}
+
+	builder.WriteString("| Kind | Span | Count | Percentage | Bar |\n")
+	builder.WriteString("| :--- | :--- | ---: | ---: | :--- |\n")
+
+	maximum := chr.maxMagnitude()
+
+	for _, ntr := range chr.entries {
+		bar, _ := chr.textBar(ntr, maximum)
+
+		cells := []string{
+			ntr.item.Kind.String(),
+			chr.name(ntr),
+			strconv.FormatUint(ntr.quantity, decimalBase),
+			formatPercentage(chr.percentage(ntr)),
+			bar,
+		}
+
+		builder.WriteString("|")
+
+		for _, cell := range cells {
+			builder.WriteString(" ")
+			builder.WriteString(escapeMarkdown(cell))
+			builder.WriteString(" |")
+		}
+
+		builder.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+// Escapes characters that break the layout of a table cell.
+func escapeMarkdown(text string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		"|", `\|`,
+		"\n", " ",
+	)
+
+	return replacer.Replace(text)
+}
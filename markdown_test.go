@@ -0,0 +1,64 @@
+package stat
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatMarkdown(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Markdown(buffer, WithWidth(10), WithCollapse()))
+	requireGolden(t, "markdown.golden", buffer.String())
+}
+
+func TestStatMarkdownOptions(t *testing.T) {
+	stat := newGraphTestStatOf[int64](t)
+
+	formatter := func(item Item[int64]) string {
+		return strconv.FormatInt(item.Span.Begin, decimalBase) + "|" + strconv.FormatInt(item.Span.End, decimalBase)
+	}
+
+	buffer := new(strings.Builder)
+
+	require.NoError(
+		t,
+		stat.Markdown(
+			buffer,
+			WithWidth(10),
+			WithASCII(),
+			WithLogarithmic(),
+			WithLabelFormatter(formatter),
+		),
+	)
+	requireGolden(t, "markdown_options.golden", buffer.String())
+}
+
+func TestStatMarkdownError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.Markdown(nil, WithWidth(-1)))
+
+	require.ErrorIs(
+		t,
+		stat.Markdown(nil, WithLabelFormatter(func(Item[uint]) string { return "" })),
+		ErrLabelFormatterMismatch,
+	)
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.Markdown(nil))
+
+	os.Stdout = stdout
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	require.Equal(t, `[1\|2] a\\b c`, escapeMarkdown("[1|2] a\\b\nc"))
+}
@@ -0,0 +1,88 @@
+package stat
+
+import (
+	"math"
+)
+
+// Behavior of statistics on overflow of the quantity of occurrences.
+type Overflow int
+
+const (
+	// Quantity of occurrences wraps around to zero (modulo arithmetic). This is
+	// the default behavior
+	OverflowWrap Overflow = iota
+
+	// Quantity of occurrences is limited by the maximum value of uint64
+	OverflowSaturate
+
+	// Quantity of occurrences is not changed and the [ErrQuantityOverflow] error is
+	// recorded
+	OverflowError
+)
+
+func (ovf Overflow) String() string {
+	switch ovf {
+	case OverflowWrap:
+		return "wrap"
+	case OverflowSaturate:
+		return "saturate"
+	case OverflowError:
+		return "error"
+	}
+
+	return "unexpected"
+}
+
+// Sets the behavior of statistics on overflow of the quantity of occurrences.
+//
+// Behavior is applied equally to regular and special items.
+func (st *Stat[Type]) SetOverflow(overflow Overflow) {
+	st.overflow = overflow
+}
+
+// Increases the quantity of occurrences of the specified value by the specified
+// quantity.
+//
+// Error is returned only in the [OverflowError] mode on overflow of the quantity
+// of occurrences.
+func (st *Stat[Type]) Add(value Type, quantity uint64) error {
+	return st.increase(st.find(value), quantity)
+}
+
+// Returns the [ErrQuantityOverflow] error if an overflow of the quantity of
+// occurrences has occurred in the [OverflowError] mode, otherwise returns nil.
+func (st *Stat[Type]) Err() error {
+	return st.err
+}
+
+func (st *Stat[Type]) increase(item *Item[Type], quantity uint64) error {
+	if err := addQuantity(&item.Quantity, quantity, st.overflow); err != nil {
+		st.err = err
+		return err
+	}
+
+	return nil
+}
+
+// Increases the quantity of occurrences by the specified quantity with the
+// specified behavior on overflow.
+//
+// Returns the [ErrQuantityOverflow] error only in the [OverflowError] mode, in
+// this case the quantity of occurrences is not changed.
+func addQuantity(target *uint64, quantity uint64, overflow Overflow) error {
+	if *target <= math.MaxUint64-quantity {
+		*target += quantity
+		return nil
+	}
+
+	switch overflow {
+	case OverflowSaturate:
+		*target = math.MaxUint64
+	case OverflowError:
+		return ErrQuantityOverflow
+	default:
+		*target += quantity
+	}
+
+	return nil
+}
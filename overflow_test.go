@@ -0,0 +1,139 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatOverflowWrap(t *testing.T) {
+	st, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, st.Add(0, math.MaxUint64))
+	require.NoError(t, st.Add(5, math.MaxUint64))
+	require.NoError(t, st.Add(11, math.MaxUint64-1))
+
+	st.Inc(0)
+	st.Inc(5)
+	require.NoError(t, st.Add(11, 3))
+
+	require.Zero(t, st.negInf.Quantity)
+	require.Zero(t, st.items[0].Quantity)
+	require.Equal(t, uint64(1), st.posInf.Quantity)
+	require.NoError(t, st.Err())
+}
+
+func TestStatOverflowSaturate(t *testing.T) {
+	st, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	st.SetOverflow(OverflowSaturate)
+
+	require.NoError(t, st.Add(0, math.MaxUint64-1))
+	require.NoError(t, st.Add(5, math.MaxUint64))
+	require.NoError(t, st.Add(11, math.MaxUint64))
+
+	st.Inc(0)
+	st.Inc(0)
+	st.Inc(5)
+	require.NoError(t, st.Add(11, math.MaxUint64))
+
+	require.Equal(t, uint64(math.MaxUint64), st.negInf.Quantity)
+	require.Equal(t, uint64(math.MaxUint64), st.items[0].Quantity)
+	require.Equal(t, uint64(math.MaxUint64), st.posInf.Quantity)
+	require.NoError(t, st.Err())
+}
+
+func TestStatOverflowError(t *testing.T) {
+	st, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	st.SetOverflow(OverflowError)
+
+	require.NoError(t, st.Add(5, math.MaxUint64-1))
+	require.NoError(t, st.Add(11, math.MaxUint64))
+
+	st.Inc(5)
+	require.NoError(t, st.Err())
+
+	st.Inc(5)
+	require.ErrorIs(t, st.Err(), ErrQuantityOverflow)
+	require.ErrorIs(t, st.Add(11, 1), ErrQuantityOverflow)
+	require.NoError(t, st.Add(0, 1))
+
+	require.Equal(t, uint64(math.MaxUint64), st.items[0].Quantity)
+	require.Equal(t, uint64(math.MaxUint64), st.posInf.Quantity)
+	require.Equal(t, uint64(1), st.negInf.Quantity)
+	require.ErrorIs(t, st.Err(), ErrQuantityOverflow)
+}
+
+func TestStatOverflowLayout(t *testing.T) {
+	st, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	st.SetOverflow(OverflowSaturate)
+
+	copied := st.layout()
+
+	require.NoError(t, copied.Add(5, math.MaxUint64))
+	require.NoError(t, copied.Add(5, 1))
+	require.Equal(t, uint64(math.MaxUint64), copied.items[0].Quantity)
+}
+
+func TestOverflowString(t *testing.T) {
+	require.Equal(t, "wrap", OverflowWrap.String())
+	require.Equal(t, "saturate", OverflowSaturate.String())
+	require.Equal(t, "error", OverflowError.String())
+	require.Equal(t, "unexpected", Overflow(-1).String())
+}
+
+func TestStatOverflowMerge(t *testing.T) {
+	first, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	second := first.layout()
+
+	require.NoError(t, first.Add(5, math.MaxUint64))
+	require.NoError(t, second.Add(5, 2))
+	require.NoError(t, second.Add(11, 1))
+
+	first.SetOverflow(OverflowSaturate)
+	require.NoError(t, first.merge(second))
+	require.Equal(t, uint64(math.MaxUint64), first.items[0].Quantity)
+	require.Equal(t, uint64(1), first.posInf.Quantity)
+
+	first.SetOverflow(OverflowError)
+	require.ErrorIs(t, first.merge(second), ErrQuantityOverflow)
+	require.ErrorIs(t, first.Err(), ErrQuantityOverflow)
+	require.Equal(t, uint64(math.MaxUint64), first.items[0].Quantity)
+}
+
+func TestVecOverflowAggregate(t *testing.T) {
+	factory := func() (*Stat[int], error) {
+		st, err := NewLinear(1, 10, 5)
+		if err != nil {
+			return nil, err
+		}
+
+		st.SetOverflow(OverflowError)
+
+		return st, nil
+	}
+
+	vec, err := NewVec(factory, 0, "overflow")
+	require.NoError(t, err)
+
+	first, err := vec.entry("first")
+	require.NoError(t, err)
+	require.NoError(t, first.stat.Add(5, math.MaxUint64))
+
+	require.NoError(t, vec.Inc("second", 5))
+
+	_, err = vec.Aggregate("first")
+	require.NoError(t, err)
+
+	_, err = vec.Aggregate()
+	require.ErrorIs(t, err, ErrQuantityOverflow)
+}
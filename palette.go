@@ -0,0 +1,100 @@
+package stat
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// Colors of image elements.
+//
+// Unspecified (nil) colors are replaced by the colors of the default palette.
+type Palette struct {
+	// Image background
+	Background color.Color
+
+	// Axes and text
+	Foreground color.Color
+
+	// Bars of regular items
+	Regular color.Color
+
+	// Bar of missed item
+	Missed color.Color
+
+	// Bar of negative infinity item
+	NegInf color.Color
+
+	// Bar of positive infinity item
+	PosInf color.Color
+
+	// Quantile markers
+	Quantile color.Color
+}
+
+// Returns the default palette.
+func DefaultPalette() Palette {
+	palette := Palette{
+		Background: color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		Foreground: color.NRGBA{R: 0x33, G: 0x33, B: 0x33, A: 0xff},
+		Regular:    color.NRGBA{R: 0x4e, G: 0x79, B: 0xa7, A: 0xff},
+		Missed:     color.NRGBA{R: 0xba, G: 0xb0, B: 0xac, A: 0xff},
+		NegInf:     color.NRGBA{R: 0xe1, G: 0x57, B: 0x59, A: 0xff},
+		PosInf:     color.NRGBA{R: 0xf2, G: 0x8e, B: 0x2b, A: 0xff},
+		Quantile:   color.NRGBA{R: 0x59, G: 0xa1, B: 0x4f, A: 0xff},
+	}
+
+	return palette
+}
+
+// Sets the colors of image elements for image renderers.
+func WithPalette(palette Palette) GraphOption {
+	return func(opts *graphOpts) {
+		opts.palette = palette
+	}
+}
+
+// Returns a palette in which unspecified colors are replaced by the colors of the
+// default palette.
+func (plt Palette) complete() Palette {
+	defaults := DefaultPalette()
+
+	plt.Background = colorOr(plt.Background, defaults.Background)
+	plt.Foreground = colorOr(plt.Foreground, defaults.Foreground)
+	plt.Regular = colorOr(plt.Regular, defaults.Regular)
+	plt.Missed = colorOr(plt.Missed, defaults.Missed)
+	plt.NegInf = colorOr(plt.NegInf, defaults.NegInf)
+	plt.PosInf = colorOr(plt.PosInf, defaults.PosInf)
+	plt.Quantile = colorOr(plt.Quantile, defaults.Quantile)
+
+	return plt
+}
+
+// Returns the color of the bar of an item of the specified kind.
+func (plt Palette) bar(kind ItemKind) color.Color {
+	switch kind {
+	case ItemKindMissed:
+		return plt.Missed
+	case ItemKindNegInf:
+		return plt.NegInf
+	case ItemKindPosInf:
+		return plt.PosInf
+	}
+
+	return plt.Regular
+}
+
+func colorOr(clr, fallback color.Color) color.Color {
+	if clr == nil {
+		return fallback
+	}
+
+	return clr
+}
+
+// Returns the color in the hexadecimal notation used in CSS, alpha channel is
+// ignored.
+func hexColor(clr color.Color) string {
+	nrgba, _ := color.NRGBAModel.Convert(clr).(color.NRGBA)
+
+	return fmt.Sprintf("#%02x%02x%02x", nrgba.R, nrgba.G, nrgba.B)
+}
@@ -0,0 +1,27 @@
+package stat
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPalette(t *testing.T) {
+	palette := Palette{Regular: color.Black}.complete()
+
+	require.Equal(t, color.Black, palette.bar(ItemKindRegular))
+	require.Equal(t, DefaultPalette().Missed, palette.bar(ItemKindMissed))
+	require.Equal(t, DefaultPalette().NegInf, palette.bar(ItemKindNegInf))
+	require.Equal(t, DefaultPalette().PosInf, palette.bar(ItemKindPosInf))
+	require.Equal(t, DefaultPalette().Background, palette.Background)
+	require.Equal(t, DefaultPalette().Foreground, palette.Foreground)
+	require.Equal(t, DefaultPalette().Quantile, palette.Quantile)
+}
+
+func TestHexColor(t *testing.T) {
+	require.Equal(t, "#000000", hexColor(color.Black))
+	require.Equal(t, "#ffffff", hexColor(color.White))
+	require.Equal(t, "#4e79a7", hexColor(DefaultPalette().Regular))
+	require.Equal(t, "#ff0000", hexColor(color.RGBA{R: 0x80, A: 0x80}))
+}
@@ -0,0 +1,195 @@
+package stat
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a PNG image of a bar chart to the specified writer.
+//
+// Missed, negative and positive infinity items are drawn in distinct colors.
+// Horizontal axis is labeled with the spans of items, vertical axis is labeled
+// with the maximum quantity of occurrences. Labels are drawn with the embedded
+// bitmap font that contains only the characters used in labels.
+//
+// The size of the image is set by the [WithImageSize] option, the colors are set
+// by the [WithPalette] option, the quantile markers are enabled by the
+// [WithQuantiles] option.
+//
+// If writer is not specified (is nil), the image will be written to standard
+// output.
+func (st *Stat[Type]) WritePNG(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return writePNG(writer, chr)
+}
+
+func writePNG[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	// Embedded font contains only ASCII characters
+	chr.opts.ascii = true
+
+	geo := newGeometry(chr)
+	palette := chr.opts.palette
+
+	img := image.NewRGBA(image.Rect(0, 0, chr.opts.imageWidth, chr.opts.imageHeight))
+
+	fillRect(img, img.Bounds(), palette.Background)
+
+	if chr.opts.logarithmic {
+		drawText(img, round(geo.left), round(geo.top/2)-textHeight()/2, logarithmicMarker, palette.Foreground)
+	}
+
+	drawPNGAxes(img, chr, geo)
+
+	for id, ntr := range chr.entries {
+		drawPNGBar(img, chr, geo, id, ntr)
+	}
+
+	for _, mrk := range chr.markers {
+		drawPNGMarker(img, geo, mrk, palette.Quantile)
+	}
+
+	return png.Encode(writer, img)
+}
+
+func drawPNGAxes[Type constraints.Integer](img *image.RGBA, chr chart[Type], geo geometry) {
+	left := round(geo.left)
+	top := round(geo.top)
+	base := round(geo.base())
+	right := round(geo.left + geo.plotWidth())
+
+	foreground := chr.opts.palette.Foreground
+
+	fillRect(img, image.Rect(left-1, top, left, base+1), foreground)
+	fillRect(img, image.Rect(left-1, base, right, base+1), foreground)
+
+	upper := strconv.FormatUint(chr.maxQuantity(), decimalBase)
+
+	drawText(img, left-pngTextIndent-textWidth(upper), top, upper, foreground)
+	drawText(img, left-pngTextIndent-textWidth("0"), base-textHeight(), "0", foreground)
+}
+
+func drawPNGBar[Type constraints.Integer](
+	img *image.RGBA,
+	chr chart[Type],
+	geo geometry,
+	id int,
+	ntr entry[Type],
+) {
+	x, width := geo.bar(id)
+	height := geo.barHeight(chr.magnitude(ntr))
+
+	base := round(geo.base())
+
+	rect := image.Rect(round(x), base-round(height), round(x+width), base)
+
+	fillRect(img, rect, chr.opts.palette.bar(ntr.item.Kind))
+
+	label := chr.name(ntr)
+	center := round(x + width/2)
+
+	if textWidth(label) <= round(width) {
+		drawText(img, center-textWidth(label)/2, base+pngTextIndent, label, chr.opts.palette.Foreground)
+		return
+	}
+
+	drawRotatedText(img, center-textHeight()/2, base+pngTextIndent, label, chr.opts.palette.Foreground)
+}
+
+func drawPNGMarker(img *image.RGBA, geo geometry, mrk marker, clr color.Color) {
+	x := round(geo.position(mrk.id, mrk.fraction))
+	top := round(geo.top)
+	base := round(geo.base())
+
+	for y := top; y < base; y += pngDashLength + pngDashGap {
+		fillRect(img, image.Rect(x, y, x+1, min(y+pngDashLength, base)), clr)
+	}
+
+	label := quantileLabel(mrk.quantile)
+
+	drawText(img, x-textWidth(label)/2, top-pngTextIndent-textHeight(), label, clr)
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, clr color.Color) {
+	draw.Draw(img, rect, image.NewUniform(clr), image.Point{}, draw.Src)
+}
+
+// Draws text from left to right, coordinates specify the upper left corner.
+func drawText(img *image.RGBA, x, y int, text string, clr color.Color) {
+	for _, char := range text {
+		drawGlyph(img, x, y, glyph(char), clr, false)
+		x += glyphAdvance()
+	}
+}
+
+// Draws text from top to bottom (rotated clockwise), coordinates specify the upper
+// left corner.
+func drawRotatedText(img *image.RGBA, x, y int, text string, clr color.Color) {
+	for _, char := range text {
+		drawGlyph(img, x, y, glyph(char), clr, true)
+		y += glyphAdvance()
+	}
+}
+
+func drawGlyph(img *image.RGBA, x, y int, rows [glyphHeight]uint8, clr color.Color, rotated bool) {
+	for row, bits := range rows {
+		for column := range glyphWidth {
+			if bits&(1<<(glyphWidth-1-column)) == 0 {
+				continue
+			}
+
+			dx, dy := column, row
+
+			if rotated {
+				dx, dy = glyphHeight-1-row, column
+			}
+
+			pixel := image.Rect(
+				x+dx*fontScale,
+				y+dy*fontScale,
+				x+(dx+1)*fontScale,
+				y+(dy+1)*fontScale,
+			)
+
+			fillRect(img, pixel, clr)
+		}
+	}
+}
+
+func glyphAdvance() int {
+	return (glyphWidth + 1) * fontScale
+}
+
+func textWidth(text string) int {
+	length := utf8.RuneCountInString(text)
+
+	if length == 0 {
+		return 0
+	}
+
+	return length*glyphAdvance() - fontScale
+}
+
+func textHeight() int {
+	return glyphHeight * fontScale
+}
+
+func round(number float64) int {
+	return int(math.Round(number))
+}
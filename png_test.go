@@ -0,0 +1,117 @@
+package stat
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decodePNG(t *testing.T, data []byte) image.Image {
+	t.Helper()
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	return img
+}
+
+func requireColor(t *testing.T, expected color.Color, img image.Image, x, y int) {
+	t.Helper()
+
+	require.Equal(t, color.RGBAModel.Convert(expected), color.RGBAModel.Convert(img.At(x, y)))
+}
+
+func TestStatWritePNG(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	stat.Inc(100)
+	stat.missed.Quantity = 1
+
+	buffer := new(bytes.Buffer)
+
+	require.NoError(
+		t,
+		stat.WritePNG(
+			buffer,
+			WithImageSize(400, 200),
+			WithQuantiles(0.5, 0.99),
+			WithLogarithmic(),
+		),
+	)
+
+	img := decodePNG(t, buffer.Bytes())
+	palette := DefaultPalette()
+
+	require.Equal(t, image.Rect(0, 0, 400, 200), img.Bounds())
+
+	requireColor(t, palette.Background, img, 0, 0)
+	requireColor(t, palette.Foreground, img, 59, 60)
+
+	// Bars are located at the same positions as in the SVG image
+	requireColor(t, palette.Missed, img, 78, 115)
+	requireColor(t, palette.NegInf, img, 113, 115)
+	requireColor(t, palette.Regular, img, 149, 115)
+	requireColor(t, palette.Background, img, 184, 115)
+	requireColor(t, palette.PosInf, img, 362, 115)
+	requireColor(t, palette.Quantile, img, 298, 31)
+}
+
+func TestStatWritePNGPalette(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	palette := Palette{
+		Background: color.Black,
+		Regular:    color.White,
+	}
+
+	buffer := new(bytes.Buffer)
+
+	require.NoError(t, stat.WritePNG(buffer, WithImageSize(400, 200), WithPalette(palette)))
+
+	img := decodePNG(t, buffer.Bytes())
+
+	requireColor(t, color.Black, img, 0, 0)
+	requireColor(t, color.White, img, 355, 115)
+	requireColor(t, DefaultPalette().NegInf, img, 95, 115)
+}
+
+func TestStatWritePNGEmpty(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	buffer := new(bytes.Buffer)
+
+	require.NoError(t, stat.WritePNG(buffer, WithQuantiles(0.5)))
+
+	img := decodePNG(t, buffer.Bytes())
+
+	require.Equal(t, image.Rect(0, 0, defaultImageWidth, defaultImageHeight), img.Bounds())
+}
+
+func TestStatWritePNGError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.WritePNG(io.Discard, WithImageSize(-1, 0)))
+	require.Error(t, stat.WritePNG((*os.File)(nil)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.WritePNG(nil))
+
+	os.Stdout = stdout
+}
+
+func TestFont(t *testing.T) {
+	require.Equal(t, [glyphHeight]uint8{}, glyph('?'))
+	require.Equal(t, [glyphHeight]uint8{}, glyph(' '))
+	require.Zero(t, textWidth(""))
+	require.Equal(t, glyphWidth*fontScale, textWidth("0"))
+	require.Equal(t, (2*glyphWidth+1)*fontScale, textWidth("00"))
+}
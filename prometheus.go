@@ -0,0 +1,77 @@
+package stat
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics in the Prometheus text exposition format as a histogram with
+// the specified name to the specified writer.
+//
+// Buckets are bounded by the ends of spans of items. Missed occurrences cannot be
+// represented in a histogram and are not taken into account. Sum of values is
+// estimated from the midpoints of spans in the same way as by [EarthMoversDistance].
+func (st *Stat[Type]) WritePrometheus(writer io.Writer, name string) error {
+	if !isMetricNameValid(name) {
+		return ErrMetricNameInvalid
+	}
+
+	_, err := io.WriteString(writer, prometheus(name, st.bins()))
+
+	return err
+}
+
+func prometheus[Type constraints.Integer](name string, bins []Item[Type]) string {
+	builder := new(strings.Builder)
+
+	builder.WriteString("# TYPE " + name + " histogram\n")
+
+	cumulative := uint64(0)
+	sum := 0.0
+
+	for _, item := range bins {
+		cumulative = addSat(cumulative, item.Quantity)
+		sum += binPosition(item) * float64(item.Quantity)
+
+		if item.Kind == ItemKindPosInf {
+			continue
+		}
+
+		builder.WriteString(name + `_bucket{le="` + formatInteger(item.Span.End) + `"} `)
+		builder.WriteString(strconv.FormatUint(cumulative, decimalBase) + "\n")
+	}
+
+	count := strconv.FormatUint(cumulative, decimalBase)
+
+	builder.WriteString(name + `_bucket{le="+Inf"} ` + count + "\n")
+	builder.WriteString(name + "_sum " + strconv.FormatFloat(sum, 'g', -1, 64) + "\n")
+	builder.WriteString(name + "_count " + count + "\n")
+
+	return builder.String()
+}
+
+func isMetricNameValid(name string) bool {
+	return name != "" && metricName(name) == name
+}
+
+// Returns the specified name with characters not allowed in Prometheus metric
+// names replaced by underscores.
+func metricName(name string) string {
+	runes := []rune(name)
+
+	for id, char := range runes {
+		allowed := char == '_' || char == ':' ||
+			(char >= 'a' && char <= 'z') ||
+			(char >= 'A' && char <= 'Z') ||
+			(id != 0 && char >= '0' && char <= '9')
+
+		if !allowed {
+			runes[id] = '_'
+		}
+	}
+
+	return string(runes)
+}
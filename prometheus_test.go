@@ -0,0 +1,49 @@
+package stat
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatWritePrometheus(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	stat.Inc(0)
+	stat.Inc(2)
+	stat.Inc(12)
+	stat.Inc(12)
+	stat.Inc(30)
+
+	expected := "" +
+		"# TYPE latency histogram\n" +
+		`latency_bucket{le="0"} 1` + "\n" +
+		`latency_bucket{le="10"} 2` + "\n" +
+		`latency_bucket{le="20"} 4` + "\n" +
+		`latency_bucket{le="+Inf"} 5` + "\n" +
+		"latency_sum 57.5\n" +
+		"latency_count 5\n"
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WritePrometheus(buffer, "latency"))
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestStatWritePrometheusError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.ErrorIs(t, stat.WritePrometheus(io.Discard, ""), ErrMetricNameInvalid)
+	require.ErrorIs(t, stat.WritePrometheus(io.Discard, "1st"), ErrMetricNameInvalid)
+	require.ErrorIs(t, stat.WritePrometheus(io.Discard, "http latency"), ErrMetricNameInvalid)
+	require.Error(t, stat.WritePrometheus((*os.File)(nil), "latency"))
+}
+
+func TestMetricName(t *testing.T) {
+	require.Equal(t, "http_latency:ms_2", metricName("http_latency:ms_2"))
+	require.Equal(t, "_st_route_", metricName("1st/route…"))
+}
@@ -0,0 +1,64 @@
+//go:build !stat_nopterm
+
+package stat
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pterm/pterm"
+	"golang.org/x/exp/constraints"
+)
+
+func graph[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	bars := make([]pterm.Bar, 0, len(chr.entries))
+
+	style := &pterm.Style{
+		pterm.BgDefault,
+		pterm.FgDefault,
+	}
+
+	// Bar values are displayed by pterm only if they are exact, that is for the
+	// linear scale and quantities that fit in the bar values, otherwise bar values
+	// are scaled and exact values are displayed in the labels
+	scaled := chr.opts.logarithmic || chr.maxQuantity() > barValueLimit
+	maximum := chr.maxMagnitude()
+
+	for _, ntr := range chr.entries {
+		bar := pterm.Bar{
+			Label:      chr.label(ntr, scaled),
+			Value:      barValue(chr, ntr, maximum, scaled),
+			Style:      style,
+			LabelStyle: style,
+		}
+
+		bars = append(bars, bar)
+	}
+
+	if chr.opts.logarithmic {
+		if _, err := fmt.Fprintln(writer, logarithmicMarker); err != nil {
+			return err
+		}
+	}
+
+	chart := pterm.DefaultBarChart.WithBars(bars).WithShowValue(!scaled)
+
+	// Rendered chart is written directly, because pterm ignores write errors
+	rendered, err := chart.Srender()
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(writer, rendered+"\n")
+
+	return err
+}
+
+func barValue[Type constraints.Integer](chr chart[Type], ntr entry[Type], maximum float64, scaled bool) int {
+	if !scaled {
+		// Quantity does not exceed the limit of bar values
+		return int(ntr.quantity)
+	}
+
+	return barUnits(chr.magnitude(ntr), maximum, barResolution)
+}
@@ -0,0 +1,13 @@
+//go:build stat_nopterm
+
+package stat
+
+import (
+	"io"
+
+	"golang.org/x/exp/constraints"
+)
+
+func graph[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	return text(writer, chr)
+}
@@ -0,0 +1,93 @@
+//go:build !stat_nopterm
+
+package stat
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatGraphLarge(t *testing.T) {
+	stat, err := New([]span.Span[int]{{Begin: 0, End: 0}}, nil)
+	require.NoError(t, err)
+
+	stat.missed.Quantity = math.MaxUint64
+	stat.negInf.Quantity = 0
+	stat.items[0].Quantity = 0
+	stat.posInf.Quantity = 0
+	require.NoError(t, stat.Graph(io.Discard))
+
+	stat.missed.Quantity = 0
+	stat.negInf.Quantity = math.MaxUint64
+	stat.items[0].Quantity = 0
+	stat.posInf.Quantity = 0
+	require.NoError(t, stat.Graph(io.Discard))
+
+	stat.negInf.Quantity = 0
+	stat.items[0].Quantity = math.MaxUint64
+	stat.posInf.Quantity = 0
+	require.NoError(t, stat.Graph(io.Discard))
+
+	stat.negInf.Quantity = 0
+	stat.items[0].Quantity = 0
+	stat.posInf.Quantity = math.MaxUint64
+	require.NoError(t, stat.Graph(io.Discard))
+
+	stat.negInf.Quantity = barValueLimit + 1
+	stat.items[0].Quantity = math.MaxUint64
+	stat.posInf.Quantity = 1
+
+	buffer := new(bytes.Buffer)
+
+	require.NoError(t, stat.Graph(buffer))
+	require.Contains(t, buffer.String(), "18446744073709551615")
+	require.Contains(t, buffer.String(), "2147483648")
+
+	chr, err := newChart(stat.Items(), nil)
+	require.NoError(t, err)
+
+	maximum := chr.maxMagnitude()
+	values := make([]int, 0, len(chr.entries))
+
+	for _, ntr := range chr.entries {
+		values = append(values, barValue(chr, ntr, maximum, true))
+	}
+
+	require.Equal(t, []int{1, barResolution, 1}, values)
+}
+
+func TestStatGraphLogarithmic(t *testing.T) {
+	stat, err := NewLinear(1, 30, 10)
+	require.NoError(t, err)
+
+	stat.items[0].Quantity = 1e6
+	stat.items[1].Quantity = 3
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithLogarithmic()})
+	require.NoError(t, err)
+
+	maximum := chr.maxMagnitude()
+	values := make([]int, 0, len(chr.entries))
+
+	for _, ntr := range chr.entries {
+		values = append(values, barValue(chr, ntr, maximum, true))
+	}
+
+	require.Equal(t, []int{barResolution, 100, 0}, values)
+}
+
+func TestStatGraphLogarithmicEmpty(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	chr, err := newChart(stat.Items(), []GraphOption{WithLogarithmic()})
+	require.NoError(t, err)
+
+	require.Zero(t, barValue(chr, chr.entries[0], chr.maxMagnitude(), true))
+	require.NoError(t, stat.GraphWith(io.Discard, WithLogarithmic()))
+}
@@ -0,0 +1,115 @@
+package stat
+
+import (
+	"math"
+
+	"github.com/akramarenkov/safe"
+	"golang.org/x/exp/constraints"
+)
+
+// Returns an estimate of the value of the specified quantile.
+//
+// Quantile must be in the range [0, 1]. Occurrences in the missed item are not
+// taken into account.
+//
+// Value is estimated by linear interpolation within the span of the item
+// containing the quantile. If the quantile belongs to the item of negative
+// infinity, the end of its span is returned, and if it belongs to the item of
+// positive infinity, the beginning of its span is returned.
+func (st *Stat[Type]) Quantile(quantile float64) (Type, error) {
+	return quantileValue(st.Items(), quantile)
+}
+
+func quantileValue[Type constraints.Integer](items []Item[Type], quantile float64) (Type, error) {
+	id, fraction, err := locateQuantile(items, quantile)
+	if err != nil {
+		return 0, err
+	}
+
+	return interpolate(items[id], fraction), nil
+}
+
+// Returns the index of the item containing the specified quantile and the
+// position of the quantile within the item as a fraction of its quantity of
+// occurrences.
+func locateQuantile[Type constraints.Integer](items []Item[Type], quantile float64) (int, float64, error) {
+	if !isQuantileValid(quantile) {
+		return 0, 0, ErrQuantileInvalid
+	}
+
+	total := 0.0
+
+	for _, item := range items {
+		if item.Kind == ItemKindMissed {
+			continue
+		}
+
+		total += float64(item.Quantity)
+	}
+
+	if total == 0 {
+		return 0, 0, ErrNoOccurrences
+	}
+
+	rank := quantile * total
+	cumulative := 0.0
+	last := 0
+
+	for id, item := range items {
+		if item.Kind == ItemKindMissed || item.Quantity == 0 {
+			continue
+		}
+
+		last = id
+
+		quantity := float64(item.Quantity)
+
+		if rank <= cumulative+quantity {
+			return id, max(0, rank-cumulative) / quantity, nil
+		}
+
+		cumulative += quantity
+	}
+
+	// Can be reached only due to floating point rounding errors
+	return last, 1, nil
+}
+
+func isQuantileValid(quantile float64) bool {
+	return quantile >= 0 && quantile <= 1
+}
+
+func interpolate[Type constraints.Integer](item Item[Type], fraction float64) Type {
+	switch item.Kind {
+	case ItemKindNegInf:
+		return item.Span.End
+	case ItemKindPosInf:
+		return item.Span.Begin
+	}
+
+	if fraction >= 1 {
+		return item.Span.End
+	}
+
+	distance := safe.Dist(item.Span.End, item.Span.Begin)
+
+	// Offset of the smallest value whose share of the span is not less than the
+	// fraction, assuming a uniform distribution of values within the span.
+	// Tolerance compensates for floating point rounding errors, e.g. when quantile
+	// 0.55 is multiplied by 100 and gives slightly more than 55
+	scaled := math.Ceil(fraction*(float64(distance)+1)*(1-roundingTolerance)) - 1
+
+	// Conversion to float64 may round up the distance for large spans
+	offset := distance
+
+	switch {
+	case scaled <= 0:
+		offset = 0
+	case scaled < float64(distance):
+		offset = uint64(scaled)
+	}
+
+	// Integer overflow is possible here for signed types, but the result is
+	// correct because the sum does not exceed the end of the span
+	return item.Span.Begin + Type(offset)
+}
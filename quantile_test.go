@@ -0,0 +1,119 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/safe"
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatQuantile(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	for value := range safe.Inc(1, 100) {
+		stat.Inc(value)
+	}
+
+	quantile, err := stat.Quantile(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, quantile)
+
+	quantile, err = stat.Quantile(0.01)
+	require.NoError(t, err)
+	require.Equal(t, 1, quantile)
+
+	quantile, err = stat.Quantile(0.5)
+	require.NoError(t, err)
+	require.Equal(t, 50, quantile)
+
+	quantile, err = stat.Quantile(0.55)
+	require.NoError(t, err)
+	require.Equal(t, 55, quantile)
+
+	quantile, err = stat.Quantile(0.99)
+	require.NoError(t, err)
+	require.Equal(t, 99, quantile)
+
+	quantile, err = stat.Quantile(1)
+	require.NoError(t, err)
+	require.Equal(t, 100, quantile)
+}
+
+func TestStatQuantileSpecial(t *testing.T) {
+	spans := []span.Span[int8]{
+		{Begin: 1, End: 2},
+		{Begin: 4, End: 5},
+	}
+
+	stat, err := New(spans, nil)
+	require.NoError(t, err)
+
+	stat.Inc(-1)
+	stat.Inc(3)
+	stat.Inc(3)
+	stat.Inc(3)
+	stat.Inc(4)
+	stat.Inc(math.MaxInt8)
+
+	quantile, err := stat.Quantile(0)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), quantile)
+
+	quantile, err = stat.Quantile(0.5)
+	require.NoError(t, err)
+	require.Equal(t, int8(4), quantile)
+
+	quantile, err = stat.Quantile(1)
+	require.NoError(t, err)
+	require.Equal(t, int8(6), quantile)
+}
+
+func TestStatQuantileFullRange(t *testing.T) {
+	stat, err := NewLinearQ[int8](math.MinInt8, math.MaxInt8, 1)
+	require.NoError(t, err)
+
+	stat.Inc(0)
+
+	quantile, err := stat.Quantile(0)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MinInt8), quantile)
+
+	quantile, err = stat.Quantile(0.5)
+	require.NoError(t, err)
+	require.Equal(t, int8(-1), quantile)
+
+	quantile, err = stat.Quantile(1)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MaxInt8), quantile)
+
+	unsigned, err := NewLinearQ[uint64](0, math.MaxUint64, 1)
+	require.NoError(t, err)
+
+	unsigned.Inc(0)
+
+	max, err := unsigned.Quantile(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), max)
+}
+
+func TestStatQuantileError(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	_, err = stat.Quantile(0.5)
+	require.ErrorIs(t, err, ErrNoOccurrences)
+
+	stat.Inc(1)
+
+	_, err = stat.Quantile(-0.1)
+	require.ErrorIs(t, err, ErrQuantileInvalid)
+
+	_, err = stat.Quantile(1.1)
+	require.ErrorIs(t, err, ErrQuantileInvalid)
+
+	_, err = stat.Quantile(math.NaN())
+	require.ErrorIs(t, err, ErrQuantileInvalid)
+}
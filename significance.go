@@ -0,0 +1,363 @@
+package stat
+
+import (
+	"math"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Result of a statistical test.
+type TestResult struct {
+	// Value of the test statistic
+	Statistic float64
+
+	// Probability of obtaining the value of the test statistic at least as extreme
+	// as observed under the hypothesis that both statistics are samples of the same
+	// distribution
+	PValue float64
+}
+
+// Performs the chi-square test of homogeneity of two statistics.
+//
+// Statistics must have the same spans. Missed occurrences are not taken into
+// account. Items that are empty in both statistics are excluded from the test.
+func ChiSquare[Type constraints.Integer](baseline, candidate *Stat[Type]) (TestResult, error) {
+	first, second, err := binsPair(baseline, candidate)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	firstTotal := itemsTotal(first)
+	secondTotal := itemsTotal(second)
+	total := firstTotal + secondTotal
+
+	statistic := 0.0
+	freedom := -1
+
+	for id := range first {
+		observedFirst := float64(first[id].Quantity)
+		observedSecond := float64(second[id].Quantity)
+
+		column := observedFirst + observedSecond
+
+		if column == 0 {
+			continue
+		}
+
+		expectedFirst := firstTotal * column / total
+		expectedSecond := secondTotal * column / total
+
+		statistic += math.Pow(observedFirst-expectedFirst, 2) / expectedFirst
+		statistic += math.Pow(observedSecond-expectedSecond, 2) / expectedSecond
+
+		freedom++
+	}
+
+	if freedom <= 0 {
+		return TestResult{Statistic: statistic, PValue: 1}, nil
+	}
+
+	result := TestResult{
+		Statistic: statistic,
+		PValue:    upperGamma(float64(freedom)/2, statistic/2),
+	}
+
+	return result, nil
+}
+
+// Performs the chi-square goodness-of-fit test of statistics to the expected
+// distribution.
+//
+// Expected distribution is specified by the function that returns the
+// probability of a value to belong to the item, probabilities are normalized so
+// that their sum is equal to one. Missed occurrences are not taken into account.
+// Items with zero expected probability are excluded from the test, but if they
+// have occurrences, the statistic is infinite and the p-value is zero.
+func GoodnessOfFit[Type constraints.Integer](
+	st *Stat[Type],
+	probability func(item Item[Type]) float64,
+) (TestResult, error) {
+	bins := st.bins()
+
+	total := itemsTotal(bins)
+	if total == 0 {
+		return TestResult{}, ErrNoOccurrences
+	}
+
+	probabilities := make([]float64, len(bins))
+	sum := 0.0
+
+	for id, item := range bins {
+		probabilities[id] = probability(item)
+
+		if probabilities[id] < 0 || math.IsNaN(probabilities[id]) {
+			return TestResult{}, ErrProbabilityInvalid
+		}
+
+		sum += probabilities[id]
+	}
+
+	if sum == 0 || math.IsInf(sum, 0) {
+		return TestResult{}, ErrProbabilityInvalid
+	}
+
+	statistic := 0.0
+	freedom := -1
+
+	for id, item := range bins {
+		observed := float64(item.Quantity)
+		expected := total * probabilities[id] / sum
+
+		if expected == 0 {
+			if observed != 0 {
+				return TestResult{Statistic: math.Inf(1), PValue: 0}, nil
+			}
+
+			continue
+		}
+
+		statistic += math.Pow(observed-expected, 2) / expected
+		freedom++
+	}
+
+	if freedom <= 0 {
+		return TestResult{Statistic: statistic, PValue: 1}, nil
+	}
+
+	result := TestResult{
+		Statistic: statistic,
+		PValue:    upperGamma(float64(freedom)/2, statistic/2),
+	}
+
+	return result, nil
+}
+
+// Performs the two-sample Kolmogorov-Smirnov test of two statistics.
+//
+// Statistics must have the same spans. Missed occurrences are not taken into
+// account. Statistic is the maximum distance between cumulative distributions
+// calculated at the ends of spans, so for coarse spans it underestimates the
+// distance between original distributions and p-value is conservative.
+func KolmogorovSmirnov[Type constraints.Integer](baseline, candidate *Stat[Type]) (TestResult, error) {
+	first, second, err := binsPair(baseline, candidate)
+	if err != nil {
+		return TestResult{}, err
+	}
+
+	firstTotal := itemsTotal(first)
+	secondTotal := itemsTotal(second)
+
+	statistic := 0.0
+
+	for _, distance := range cumulativeDistances(first, second) {
+		statistic = max(statistic, math.Abs(distance))
+	}
+
+	effective := math.Sqrt(firstTotal * secondTotal / (firstTotal + secondTotal))
+
+	result := TestResult{
+		Statistic: statistic,
+		PValue:    kolmogorov((effective + ksCorrection + ksCorrectionScale/effective) * statistic),
+	}
+
+	return result, nil
+}
+
+// Calculates the Earth Mover's Distance (1st Wasserstein distance) between two
+// statistics, that is the minimum average distance between values to which
+// occurrences of the baseline statistics must be moved to obtain the candidate
+// statistics.
+//
+// Statistics must have the same spans. Missed occurrences are not taken into
+// account. Occurrences in regular items are placed at the midpoints of spans,
+// occurrences of values less than the beginning of the first span are placed at
+// the beginning of the first span, occurrences of values greater than the end of
+// the last span are placed at the end of the last span.
+func EarthMoversDistance[Type constraints.Integer](baseline, candidate *Stat[Type]) (float64, error) {
+	first, second, err := binsPair(baseline, candidate)
+	if err != nil {
+		return 0, err
+	}
+
+	distances := cumulativeDistances(first, second)
+
+	distance := 0.0
+
+	for id := range len(distances) - 1 {
+		distance += math.Abs(distances[id]) * (binPosition(first[id+1]) - binPosition(first[id]))
+	}
+
+	return distance, nil
+}
+
+// Returns the items of both statistics, excluding missed ones, in which the
+// quantities of occurrences are compared.
+func binsPair[Type constraints.Integer](baseline, candidate *Stat[Type]) ([]Item[Type], []Item[Type], error) {
+	first := baseline.bins()
+	second := candidate.bins()
+
+	if len(first) != len(second) {
+		return nil, nil, ErrLayoutsMismatch
+	}
+
+	for id := range first {
+		if first[id].Kind != second[id].Kind || first[id].Span != second[id].Span {
+			return nil, nil, ErrLayoutsMismatch
+		}
+	}
+
+	if itemsTotal(first) == 0 || itemsTotal(second) == 0 {
+		return nil, nil, ErrNoOccurrences
+	}
+
+	return first, second, nil
+}
+
+// Returns a list of items, excluding missed one. Special items are included if
+// their spans are not empty regardless of the quantity of occurrences.
+func (st *Stat[Type]) bins() []Item[Type] {
+	minimum, maximum := intspec.Range[Type]()
+
+	bins := make([]Item[Type], 0, len(st.items)+specialItemsQuantity)
+
+	if minimum < st.items[st.lower()].Span.Begin {
+		bins = append(bins, st.negInf)
+	}
+
+	bins = append(bins, st.items...)
+
+	if maximum > st.items[st.upper()].Span.End {
+		bins = append(bins, st.posInf)
+	}
+
+	return bins
+}
+
+// Returns the differences between cumulative distributions of items.
+func cumulativeDistances[Type constraints.Integer](first, second []Item[Type]) []float64 {
+	firstTotal := itemsTotal(first)
+	secondTotal := itemsTotal(second)
+
+	distances := make([]float64, len(first))
+
+	firstCumulative := 0.0
+	secondCumulative := 0.0
+
+	for id := range first {
+		firstCumulative += float64(first[id].Quantity)
+		secondCumulative += float64(second[id].Quantity)
+
+		distances[id] = firstCumulative/firstTotal - secondCumulative/secondTotal
+	}
+
+	return distances
+}
+
+// Returns the value at which the occurrences of the item are placed.
+func binPosition[Type constraints.Integer](item Item[Type]) float64 {
+	switch item.Kind {
+	case ItemKindNegInf:
+		return float64(item.Span.End) + 1
+	case ItemKindPosInf:
+		return float64(item.Span.Begin) - 1
+	}
+
+	return (float64(item.Span.Begin) + float64(item.Span.End)) / 2
+}
+
+// Calculates the regularized upper incomplete gamma function Q(a, x).
+func upperGamma(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+
+	// Common factor x^a * e^-x / Γ(a)
+	lgamma, _ := math.Lgamma(a)
+	factor := math.Exp(a*math.Log(x) - x - lgamma)
+
+	if x < a+1 {
+		return clampProbability(1 - factor*lowerGammaSeries(a, x))
+	}
+
+	return clampProbability(factor * upperGammaFraction(a, x))
+}
+
+// Calculates the series representation of the lower incomplete gamma function
+// without the common factor.
+func lowerGammaSeries(a, x float64) float64 {
+	term := 1 / a
+	sum := term
+
+	for step := 1; step <= gammaIterations; step++ {
+		term *= x / (a + float64(step))
+		sum += term
+
+		if math.Abs(term) < math.Abs(sum)*gammaEpsilon {
+			break
+		}
+	}
+
+	return sum
+}
+
+// Calculates the continued fraction representation of the upper incomplete gamma
+// function without the common factor by the modified Lentz's method.
+func upperGammaFraction(a, x float64) float64 {
+	b := x + 1 - a
+	c := 1 / gammaTiny
+	d := 1 / b
+	fraction := d
+
+	for step := 1; step <= gammaIterations; step++ {
+		an := -float64(step) * (float64(step) - a)
+		b += 2
+
+		d = an*d + b
+		if math.Abs(d) < gammaTiny {
+			d = gammaTiny
+		}
+
+		c = b + an/c
+		if math.Abs(c) < gammaTiny {
+			c = gammaTiny
+		}
+
+		d = 1 / d
+		delta := d * c
+		fraction *= delta
+
+		if math.Abs(delta-1) < gammaEpsilon {
+			break
+		}
+	}
+
+	return fraction
+}
+
+// Calculates the complementary cumulative Kolmogorov distribution function.
+func kolmogorov(lambda float64) float64 {
+	if lambda < ksMinimumLambda {
+		return 1
+	}
+
+	sum := 0.0
+	sign := 2.0
+
+	for step := 1; step <= ksIterations; step++ {
+		term := sign * math.Exp(-2*lambda*lambda*float64(step*step))
+		sum += term
+
+		if math.Abs(term) <= gammaEpsilon*math.Abs(sum) {
+			break
+		}
+
+		sign = -sign
+	}
+
+	return clampProbability(sum)
+}
+
+func clampProbability(probability float64) float64 {
+	return min(max(probability, 0), 1)
+}
@@ -0,0 +1,191 @@
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newSignificanceTestStats(t *testing.T) (*Stat[uint8], *Stat[uint8]) {
+	t.Helper()
+
+	baseline, err := NewLinear[uint8](0, 255, 128)
+	require.NoError(t, err)
+
+	candidate, err := NewLinear[uint8](0, 255, 128)
+	require.NoError(t, err)
+
+	for range 10 {
+		baseline.Inc(0)
+		candidate.Inc(200)
+	}
+
+	for range 20 {
+		baseline.Inc(200)
+		candidate.Inc(0)
+	}
+
+	return baseline, candidate
+}
+
+func TestChiSquare(t *testing.T) {
+	baseline, candidate := newSignificanceTestStats(t)
+
+	result, err := ChiSquare(baseline, candidate)
+	require.NoError(t, err)
+	require.InDelta(t, 20.0/3, result.Statistic, 1e-12)
+	require.InDelta(t, 0.009823274507519245, result.PValue, 1e-12)
+
+	result, err = ChiSquare(baseline, baseline)
+	require.NoError(t, err)
+	require.InDelta(t, 0, result.Statistic, 0)
+	require.InDelta(t, 1, result.PValue, 1e-12)
+
+	single, err := NewLinear(1, 10, 10)
+	require.NoError(t, err)
+
+	single.Inc(1)
+
+	result, err = ChiSquare(single, single)
+	require.NoError(t, err)
+	require.Equal(t, TestResult{Statistic: 0, PValue: 1}, result)
+}
+
+func TestGoodnessOfFit(t *testing.T) {
+	st, err := NewLinear(1, 4, 1)
+	require.NoError(t, err)
+
+	for value, quantity := range []int{10, 10, 20, 0} {
+		for range quantity {
+			st.Inc(value + 1)
+		}
+	}
+
+	uniform := func(item Item[int]) float64 {
+		if item.Kind != ItemKindRegular {
+			return 0
+		}
+
+		return 1
+	}
+
+	result, err := GoodnessOfFit(st, uniform)
+	require.NoError(t, err)
+	require.InDelta(t, 20, result.Statistic, 1e-12)
+	require.InDelta(t, 0.00016974243555282, result.PValue, 1e-12)
+
+	st.Inc(0)
+
+	result, err = GoodnessOfFit(st, uniform)
+	require.NoError(t, err)
+	require.Equal(t, TestResult{Statistic: math.Inf(1), PValue: 0}, result)
+
+	single := func(item Item[int]) float64 {
+		if item.Kind == ItemKindNegInf {
+			return 1
+		}
+
+		return 0
+	}
+
+	result, err = GoodnessOfFit(st, single)
+	require.NoError(t, err)
+	require.Equal(t, TestResult{Statistic: math.Inf(1), PValue: 0}, result)
+}
+
+func TestGoodnessOfFitError(t *testing.T) {
+	st, err := NewLinear(1, 4, 1)
+	require.NoError(t, err)
+
+	_, err = GoodnessOfFit(st, func(Item[int]) float64 { return 1 })
+	require.ErrorIs(t, err, ErrNoOccurrences)
+
+	st.Inc(1)
+
+	_, err = GoodnessOfFit(st, func(Item[int]) float64 { return -1 })
+	require.ErrorIs(t, err, ErrProbabilityInvalid)
+
+	_, err = GoodnessOfFit(st, func(Item[int]) float64 { return math.NaN() })
+	require.ErrorIs(t, err, ErrProbabilityInvalid)
+
+	_, err = GoodnessOfFit(st, func(Item[int]) float64 { return 0 })
+	require.ErrorIs(t, err, ErrProbabilityInvalid)
+
+	_, err = GoodnessOfFit(st, func(Item[int]) float64 { return math.Inf(1) })
+	require.ErrorIs(t, err, ErrProbabilityInvalid)
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	baseline, candidate := newSignificanceTestStats(t)
+
+	result, err := KolmogorovSmirnov(baseline, candidate)
+	require.NoError(t, err)
+	require.InDelta(t, 1.0/3, result.Statistic, 1e-12)
+	require.InDelta(t, 0.054993022248225325, result.PValue, 1e-12)
+
+	result, err = KolmogorovSmirnov(baseline, baseline)
+	require.NoError(t, err)
+	require.Equal(t, TestResult{Statistic: 0, PValue: 1}, result)
+}
+
+func TestEarthMoversDistance(t *testing.T) {
+	baseline, candidate := newSignificanceTestStats(t)
+
+	distance, err := EarthMoversDistance(baseline, candidate)
+	require.NoError(t, err)
+	require.InDelta(t, 128.0/3, distance, 1e-12)
+
+	first, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	second, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	first.Inc(-5)
+	second.Inc(25)
+
+	distance, err = EarthMoversDistance(first, second)
+	require.NoError(t, err)
+	require.InDelta(t, 19.0, distance, 1e-12)
+}
+
+func TestSignificanceError(t *testing.T) {
+	baseline, candidate := newSignificanceTestStats(t)
+
+	empty, err := NewLinear[uint8](0, 255, 128)
+	require.NoError(t, err)
+
+	other, err := NewLinear[uint8](0, 255, 64)
+	require.NoError(t, err)
+
+	shifted, err := NewLinear[uint8](1, 255, 128)
+	require.NoError(t, err)
+
+	_, err = ChiSquare(baseline, empty)
+	require.ErrorIs(t, err, ErrNoOccurrences)
+
+	_, err = KolmogorovSmirnov(empty, candidate)
+	require.ErrorIs(t, err, ErrNoOccurrences)
+
+	_, err = EarthMoversDistance(baseline, other)
+	require.ErrorIs(t, err, ErrLayoutsMismatch)
+
+	_, err = ChiSquare(baseline, shifted)
+	require.ErrorIs(t, err, ErrLayoutsMismatch)
+}
+
+func TestUpperGamma(t *testing.T) {
+	for _, x := range []float64{0, 0.1, 1, 2.5, 10, 50} {
+		require.InDelta(t, math.Exp(-x), upperGamma(1, x), 1e-12)
+		require.InDelta(t, math.Erfc(math.Sqrt(x)), upperGamma(0.5, x), 1e-12)
+	}
+
+	require.InDelta(t, 1, upperGamma(3, -1), 0)
+}
+
+func TestKolmogorov(t *testing.T) {
+	require.InDelta(t, 1, kolmogorov(0), 0)
+	require.InDelta(t, 0.26999967167735456, kolmogorov(1), 1e-12)
+	require.InDelta(t, 0, kolmogorov(10), 1e-12)
+}
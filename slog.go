@@ -0,0 +1,75 @@
+package stat
+
+import (
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Returns statistics as a group of logging attributes.
+//
+// Group contains the total quantity of occurrences (count), the lower and upper
+// bounds of the occurred values (min and max), the quantiles 0.5, 0.9, 0.99 and
+// 0.999 (p50, p90, p99 and p99.9) and the list of non-empty items (buckets) in
+// the form of space-separated pairs of item label and quantity of occurrences.
+//
+// Bounds are calculated from regular items only, see [Summary], and are omitted
+// if there are no occurrences in regular items. Quantiles are omitted if there
+// are no occurrences of values.
+func (st *Stat[Type]) LogValue() slog.Value {
+	return logValue(st.Items())
+}
+
+// Returns statistics as a group of logging attributes, see [Stat.LogValue].
+func (cnc *Concurrent[Type]) LogValue() slog.Value {
+	return logValue(cnc.Items())
+}
+
+func logValue[Type constraints.Integer](items []Item[Type]) slog.Value {
+	summary := summarize(items)
+
+	attrs := []slog.Attr{
+		slog.Uint64("count", summary.Total),
+	}
+
+	if hasRegularOccurrences(items) {
+		attrs = append(
+			attrs,
+			integerAttr("min", summary.Min),
+			integerAttr("max", summary.Max),
+		)
+	}
+
+	for _, quantile := range defaultQuantiles() {
+		value, err := quantileValue(items, quantile)
+		if err != nil {
+			break
+		}
+
+		attrs = append(attrs, integerAttr(quantileLabel(quantile), value))
+	}
+
+	buckets := make([]string, 0, len(items))
+
+	for _, item := range items {
+		if item.Quantity == 0 {
+			continue
+		}
+
+		buckets = append(buckets, itemLabel(item)+"="+strconv.FormatUint(item.Quantity, decimalBase))
+	}
+
+	attrs = append(attrs, slog.String("buckets", strings.Join(buckets, " ")))
+
+	return slog.GroupValue(attrs...)
+}
+
+func integerAttr[Type constraints.Integer](key string, number Type) slog.Attr {
+	if number < 0 {
+		return slog.Int64(key, int64(number))
+	}
+
+	return slog.Uint64(key, uint64(number))
+}
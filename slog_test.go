@@ -0,0 +1,83 @@
+package stat
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatLogValue(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+
+	logger.Info("latency", "hist", stat)
+
+	expected := `{"level":"INFO","msg":"latency","hist":{` +
+		`"count":10,"min":1,"max":60,` +
+		`"p50":45,"p90":58,"p99":60,"p99.9":60,` +
+		`"buckets":"[-Inf:0]=1 [1:10]=3 [41:50]=2 [51:60]=4"}}` + "\n"
+
+	require.JSONEq(t, expected, buffer.String())
+
+	buffer.Reset()
+
+	logger = slog.New(slog.NewTextHandler(buffer, nil))
+	logger.Info("latency", "hist", NewConcurrent(stat))
+
+	require.Contains(
+		t,
+		buffer.String(),
+		`hist.count=10 hist.min=1 hist.max=60 hist.p50=45`,
+	)
+}
+
+func TestStatLogValueEmpty(t *testing.T) {
+	stat, err := NewLinear[uint](1, 20, 10)
+	require.NoError(t, err)
+
+	expected := slog.GroupValue(
+		slog.Uint64("count", 0),
+		slog.String("buckets", ""),
+	)
+
+	require.True(t, expected.Equal(stat.LogValue()))
+
+	stat.missed.Quantity = 1
+
+	expected = slog.GroupValue(
+		slog.Uint64("count", 1),
+		slog.String("buckets", "[missed]=1"),
+	)
+
+	require.True(t, expected.Equal(stat.LogValue()))
+}
+
+func TestStatLogValueSpecial(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	stat.Inc(-5)
+
+	expected := slog.GroupValue(
+		slog.Uint64("count", 1),
+		slog.Uint64("p50", 0),
+		slog.Uint64("p90", 0),
+		slog.Uint64("p99", 0),
+		slog.Uint64("p99.9", 0),
+		slog.String("buckets", "[-Inf:0]=1"),
+	)
+
+	require.True(t, expected.Equal(stat.LogValue()))
+}
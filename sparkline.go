@@ -0,0 +1,63 @@
+package stat
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a single-line sparkline to the specified writer.
+//
+// Each item is represented by one character whose height is proportional to the
+// quantity of occurrences. Empty items are represented by a space. If the
+// [WithASCII] option is specified, heights are represented by ASCII characters.
+//
+// If writer is not specified (is nil), the sparkline will be written to standard
+// output.
+func (st *Stat[Type]) Sparkline(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return sparkline(writer, chr)
+}
+
+func sparkline[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	levels := []rune(chr.levels())
+
+	builder := new(strings.Builder)
+	maximum := chr.maxMagnitude()
+
+	for _, ntr := range chr.entries {
+		level := barUnits(chr.magnitude(ntr), maximum, len(levels))
+
+		if level == 0 {
+			builder.WriteString(" ")
+			continue
+		}
+
+		builder.WriteRune(levels[level-1])
+	}
+
+	builder.WriteString("\n")
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+// Returns characters representing increasing heights.
+func (chr chart[Type]) levels() string {
+	if chr.opts.ascii {
+		return asciiLevels
+	}
+
+	return lowerBlocks
+}
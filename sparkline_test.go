@@ -0,0 +1,58 @@
+package stat
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatSparkline(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Sparkline(buffer))
+	requireGolden(t, "sparkline.golden", buffer.String())
+}
+
+func TestStatSparklineASCII(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Sparkline(buffer, WithASCII(), WithCollapse()))
+	requireGolden(t, "sparkline_ascii.golden", buffer.String())
+}
+
+func TestStatSparklineLogarithmic(t *testing.T) {
+	stat, err := NewLinear(1, 80, 10)
+	require.NoError(t, err)
+
+	stat.items[0].Quantity = 1e6
+	stat.items[1].Quantity = 1e5
+	stat.items[2].Quantity = 1e4
+	stat.items[3].Quantity = 1e3
+	stat.items[4].Quantity = 1e2
+	stat.items[5].Quantity = 1e1
+	stat.items[6].Quantity = 1
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Sparkline(buffer, WithLogarithmic()))
+	requireGolden(t, "sparkline_logarithmic.golden", buffer.String())
+}
+
+func TestStatSparklineError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.Sparkline(nil, WithTop(-1)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.Sparkline(nil))
+
+	os.Stdout = stdout
+}
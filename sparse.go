@@ -0,0 +1,216 @@
+package stat
+
+import (
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/akramarenkov/safe"
+	"github.com/akramarenkov/span"
+	"golang.org/x/exp/constraints"
+)
+
+// Sparse linear statistics that stores only items with occurrences.
+//
+// Unlike statistics created by [NewLinear], its memory consumption does not depend
+// on the quantity of items, so it is suitable for huge ranges of values. Span to
+// which a value belongs is calculated from the layout.
+type Sparse[Type constraints.Integer] struct {
+	err      error
+	items    map[uint64]uint64
+	lower    Type
+	negInf   Item[Type]
+	overflow Overflow
+	posInf   Item[Type]
+	upper    Type
+	width    Type
+}
+
+// Creates a sparse linear statistics whose items have the specified width.
+func NewSparseLinear[Type constraints.Integer](lower, upper, width Type) (*Sparse[Type], error) {
+	if lower > upper {
+		return nil, ErrLowerGreaterUpper
+	}
+
+	if width < 0 {
+		return nil, span.ErrSpanWidthNegative
+	}
+
+	if width == 0 {
+		return nil, span.ErrSpanWidthZero
+	}
+
+	sps := &Sparse[Type]{
+		items:  make(map[uint64]uint64),
+		lower:  lower,
+		negInf: Item[Type]{Kind: ItemKindNegInf},
+		posInf: Item[Type]{Kind: ItemKindPosInf},
+		upper:  upper,
+		width:  width,
+	}
+
+	minimum, maximum := intspec.Range[Type]()
+
+	if minimum < lower {
+		sps.negInf.Span = span.Span[Type]{Begin: minimum, End: lower - 1}
+	}
+
+	if maximum > upper {
+		sps.posInf.Span = span.Span[Type]{Begin: upper + 1, End: maximum}
+	}
+
+	return sps, nil
+}
+
+// Increases the quantity of occurrences of the specified value.
+//
+// On overflow of the quantity of occurrences it behaves as specified by
+// [Sparse.SetOverflow], in the [OverflowError] mode the error is available via
+// [Sparse.Err].
+func (sps *Sparse[Type]) Inc(value Type) {
+	_ = sps.Add(value, 1)
+}
+
+// Increases the quantity of occurrences of the specified value by the specified
+// quantity.
+//
+// Error is returned only in the [OverflowError] mode on overflow of the quantity
+// of occurrences.
+func (sps *Sparse[Type]) Add(value Type, quantity uint64) error {
+	var err error
+
+	switch {
+	case value < sps.lower:
+		err = addQuantity(&sps.negInf.Quantity, quantity, sps.overflow)
+	case value > sps.upper:
+		err = addQuantity(&sps.posInf.Quantity, quantity, sps.overflow)
+	default:
+		id := safe.Dist(value, sps.lower) / uint64(sps.width)
+		current := sps.items[id]
+
+		err = addQuantity(&current, quantity, sps.overflow)
+
+		// Only items with occurrences are stored
+		if current == 0 {
+			delete(sps.items, id)
+		} else {
+			sps.items[id] = current
+		}
+	}
+
+	if err != nil {
+		sps.err = err
+		return err
+	}
+
+	return nil
+}
+
+// Sets the behavior of statistics on overflow of the quantity of occurrences.
+//
+// Behavior is applied equally to regular and special items.
+func (sps *Sparse[Type]) SetOverflow(overflow Overflow) {
+	sps.overflow = overflow
+}
+
+// Returns the [ErrQuantityOverflow] error if an overflow of the quantity of
+// occurrences has occurred in the [OverflowError] mode, otherwise returns nil.
+func (sps *Sparse[Type]) Err() error {
+	return sps.err
+}
+
+// Returns the span of the item with the specified index.
+func (sps *Sparse[Type]) spanOf(id uint64) span.Span[Type] {
+	// Offset does not exceed the distance between the lower and upper values, so
+	// the addition is performed modulo and gives the correct result for signed
+	// types too
+	begin := sps.lower + Type(id*uint64(sps.width))
+
+	end, err := safe.Add(begin, sps.width-1)
+	if err != nil || end > sps.upper {
+		end = sps.upper
+	}
+
+	return span.Span[Type]{Begin: begin, End: end}
+}
+
+// Returns a list of statistics items with occurrences.
+//
+// Empty items are not included.
+func (sps *Sparse[Type]) Items() []Item[Type] {
+	items := make([]Item[Type], 0, len(sps.items)+specialItemsQuantity)
+
+	if sps.negInf.Quantity != 0 {
+		items = append(items, sps.negInf)
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(sps.items)) {
+		item := Item[Type]{
+			Kind:     ItemKindRegular,
+			Quantity: sps.items[id],
+			Span:     sps.spanOf(id),
+		}
+
+		items = append(items, item)
+	}
+
+	if sps.posInf.Quantity != 0 {
+		items = append(items, sps.posInf)
+	}
+
+	return items
+}
+
+// Returns statistics with the layout of sparse statistics restricted to the first
+// item, the last item and items with occurrences.
+//
+// Values between spans of these items are considered missed in the returned
+// statistics, its -Inf and +Inf items are the same as in sparse statistics.
+//
+// Returned statistics is a copy and is not changed by subsequent increases.
+func (sps *Sparse[Type]) Snapshot() (*Stat[Type], error) {
+	ids := slices.Sorted(maps.Keys(sps.items))
+
+	if len(ids) == 0 || ids[0] != 0 {
+		ids = slices.Insert(ids, 0, 0)
+	}
+
+	if last := safe.Dist(sps.upper, sps.lower) / uint64(sps.width); ids[len(ids)-1] != last {
+		ids = append(ids, last)
+	}
+
+	spans := make([]span.Span[Type], 0, len(ids))
+
+	for _, id := range ids {
+		spans = append(spans, sps.spanOf(id))
+	}
+
+	snapshot, err := New(spans, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for position, id := range ids {
+		snapshot.items[position].Quantity = sps.items[id]
+	}
+
+	snapshot.negInf.Quantity = sps.negInf.Quantity
+	snapshot.overflow = sps.overflow
+	snapshot.posInf.Quantity = sps.posInf.Quantity
+
+	return snapshot, nil
+}
+
+// Writes statistics as a bar chart to the specified writer.
+//
+// Bar chart contains the first item, the last item and items with occurrences.
+// See [Stat.GraphWith] for details.
+func (sps *Sparse[Type]) GraphWith(writer io.Writer, opts ...GraphOption) error {
+	snapshot, err := sps.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	return snapshot.GraphWith(writer, opts...)
+}
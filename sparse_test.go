@@ -0,0 +1,197 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseLinear(t *testing.T) {
+	sps, err := NewSparseLinear[uint64](1, 1<<40, 1)
+	require.NoError(t, err)
+	require.Empty(t, sps.Items())
+
+	sps.Inc(0)
+	sps.Inc(1 << 40)
+	sps.Inc(1 << 40)
+	sps.Inc(1<<40 + 1)
+	sps.Inc(5)
+
+	expected := []Item[uint64]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[uint64]{Begin: 0, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[uint64]{Begin: 5, End: 5}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[uint64]{Begin: 1 << 40, End: 1 << 40}},
+		{
+			Kind:     ItemKindPosInf,
+			Quantity: 1,
+			Span:     span.Span[uint64]{Begin: 1<<40 + 1, End: math.MaxUint64},
+		},
+	}
+
+	require.Equal(t, expected, sps.Items())
+}
+
+func TestSparseLinearDense(t *testing.T) {
+	for _, width := range []int8{1, 3, 50, 127} {
+		sps, err := NewSparseLinear[int8](-100, 100, width)
+		require.NoError(t, err)
+
+		st, err := NewLinear[int8](-100, 100, width)
+		require.NoError(t, err)
+
+		for value := range 256 {
+			if value%3 == 0 {
+				continue
+			}
+
+			sps.Inc(int8(value + math.MinInt8))
+			st.Inc(int8(value + math.MinInt8))
+		}
+
+		expected := make([]Item[int8], 0)
+
+		for _, item := range st.Items() {
+			if item.Quantity != 0 {
+				expected = append(expected, item)
+			}
+		}
+
+		require.Equal(t, expected, sps.Items(), width)
+	}
+}
+
+func TestSparseLinearFullRange(t *testing.T) {
+	sps, err := NewSparseLinear[int64](math.MinInt64, math.MaxInt64, math.MaxInt64)
+	require.NoError(t, err)
+
+	sps.Inc(math.MinInt64)
+	sps.Inc(math.MaxInt64)
+
+	expected := []Item[int64]{
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int64]{Begin: math.MinInt64, End: -2}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int64]{Begin: math.MaxInt64 - 1, End: math.MaxInt64}},
+	}
+
+	require.Equal(t, expected, sps.Items())
+}
+
+func TestSparseSnapshot(t *testing.T) {
+	sps, err := NewSparseLinear(1, 1000, 10)
+	require.NoError(t, err)
+
+	snapshot, err := sps.Snapshot()
+	require.NoError(t, err)
+
+	expected := []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 991, End: 1000}},
+	}
+
+	require.Equal(t, expected, snapshot.Items())
+	require.NoError(t, sps.GraphWith(io.Discard))
+
+	sps.Inc(0)
+	sps.Inc(2000)
+
+	snapshot, err = sps.Snapshot()
+	require.NoError(t, err)
+
+	expected = []Item[int]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 991, End: 1000}},
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[int]{Begin: 1001, End: math.MaxInt}},
+	}
+
+	require.Equal(t, expected, snapshot.Items())
+	require.NoError(t, sps.GraphWith(io.Discard))
+
+	sps.Inc(15)
+	sps.Inc(995)
+
+	snapshot, err = sps.Snapshot()
+	require.NoError(t, err)
+
+	expected = []Item[int]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 991, End: 1000}},
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[int]{Begin: 1001, End: math.MaxInt}},
+	}
+
+	require.Equal(t, expected, snapshot.Items())
+
+	sps.Inc(500)
+	require.Equal(t, uint64(4), snapshot.Summary().Total)
+
+	require.NoError(t, sps.GraphWith(io.Discard))
+}
+
+func TestSparseSnapshotSingle(t *testing.T) {
+	sps, err := NewSparseLinear(1, 5, 10)
+	require.NoError(t, err)
+
+	sps.Inc(3)
+
+	snapshot, err := sps.Snapshot()
+	require.NoError(t, err)
+
+	expected := []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 1, End: 5}},
+	}
+
+	require.Equal(t, expected, snapshot.Items())
+}
+
+func TestSparseLinearError(t *testing.T) {
+	_, err := NewSparseLinear(2, 1, 1)
+	require.ErrorIs(t, err, ErrLowerGreaterUpper)
+
+	_, err = NewSparseLinear(1, 2, -1)
+	require.ErrorIs(t, err, span.ErrSpanWidthNegative)
+
+	_, err = NewSparseLinear(1, 2, 0)
+	require.ErrorIs(t, err, span.ErrSpanWidthZero)
+}
+
+func TestSparseOverflow(t *testing.T) {
+	sps, err := NewSparseLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	require.NoError(t, sps.Add(0, math.MaxUint64))
+	require.NoError(t, sps.Add(5, math.MaxUint64))
+	require.NoError(t, sps.Add(11, math.MaxUint64))
+
+	sps.Inc(0)
+	sps.Inc(5)
+	require.NoError(t, sps.Add(11, 2))
+	require.NoError(t, sps.Err())
+
+	expected := []Item[int]{
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[int]{Begin: 11, End: math.MaxInt}},
+	}
+
+	require.Equal(t, expected, sps.Items())
+
+	sps.SetOverflow(OverflowSaturate)
+
+	require.NoError(t, sps.Add(6, math.MaxUint64))
+	require.NoError(t, sps.Add(6, 1))
+	require.Equal(t, uint64(math.MaxUint64), sps.items[1])
+
+	sps.SetOverflow(OverflowError)
+
+	require.ErrorIs(t, sps.Add(6, 1), ErrQuantityOverflow)
+	require.NoError(t, sps.Add(0, 1))
+	require.ErrorIs(t, sps.Err(), ErrQuantityOverflow)
+	require.Equal(t, uint64(math.MaxUint64), sps.items[1])
+	require.Equal(t, uint64(1), sps.negInf.Quantity)
+
+	snapshot, err := sps.Snapshot()
+	require.NoError(t, err)
+	require.ErrorIs(t, snapshot.Add(6, 1), ErrQuantityOverflow)
+}
@@ -1,23 +1,23 @@
 package stat
 
 import (
-	"fmt"
 	"io"
 	"os"
 	"slices"
 
 	"github.com/akramarenkov/intspec"
-	"github.com/akramarenkov/safe"
 	"github.com/akramarenkov/span"
-	"github.com/pterm/pterm"
 	"golang.org/x/exp/constraints"
 )
 
 // Statistics.
 type Stat[Type constraints.Integer] struct {
+	err       error
+	expansion *expansion[Type]
 	items     []Item[Type]
 	missed    Item[Type]
 	negInf    Item[Type]
+	overflow  Overflow
 	posInf    Item[Type]
 	predictor Predictor[Type]
 }
@@ -96,22 +96,44 @@ func (st *Stat[Type]) prepare() {
 }
 
 // Increases the quantity of occurrences of the specified value.
+//
+// On overflow of the quantity of occurrences it behaves as specified by
+// [Stat.SetOverflow], in the [OverflowError] mode the error is available via
+// [Stat.Err].
 func (st *Stat[Type]) Inc(value Type) {
+	_ = st.increase(st.find(value), 1)
+}
+
+// Returns the item to which the specified value belongs.
+//
+// Layout of expandable statistics is extended if the value is beyond its range.
+func (st *Stat[Type]) find(value Type) *Item[Type] {
+	id := st.locate(value)
+
+	if id == 0 || id == len(st.items)+1 {
+		if st.expand(value) {
+			id = st.locate(value)
+		}
+	}
+
+	return st.slot(id)
+}
+
+// Returns the index of the slot to which the specified value belongs.
+//
+// Slots are the negative infinity item, regular items, the positive infinity item
+// and the missed item in that order.
+func (st *Stat[Type]) locate(value Type) int {
 	if value < st.items[st.lower()].Span.Begin {
-		// Integer overflow is possible here and below, but it will take a long time
-		// and this case cannot be tested
-		st.negInf.Quantity++
-		return
+		return 0
 	}
 
 	if value > st.items[st.upper()].Span.End {
-		st.posInf.Quantity++
-		return
+		return len(st.items) + 1
 	}
 
 	if st.predictor != nil {
-		st.items[st.predictor(value)].Quantity++
-		return
+		return int(st.predictor(value)) + 1
 	}
 
 	target := Item[Type]{
@@ -119,11 +141,73 @@ func (st *Stat[Type]) Inc(value Type) {
 	}
 
 	if id, found := slices.BinarySearchFunc(st.items, target, search); found {
-		st.items[id].Quantity++
-		return
+		return id + 1
+	}
+
+	return len(st.items) + 2
+}
+
+// Returns the quantity of slots.
+func (st *Stat[Type]) slots() int {
+	return len(st.items) + specialItemsQuantity
+}
+
+// Returns the item corresponding to the slot with the specified index.
+func (st *Stat[Type]) slot(id int) *Item[Type] {
+	switch id {
+	case 0:
+		return &st.negInf
+	case len(st.items) + 1:
+		return &st.posInf
+	case len(st.items) + 2:
+		return &st.missed
+	}
+
+	return &st.items[id-1]
+}
+
+// Returns a copy of statistics with zero quantities of occurrences.
+//
+// Layout of the copy is not extended even if the statistics is expandable.
+func (st *Stat[Type]) layout() *Stat[Type] {
+	copied := &Stat[Type]{
+		items:     slices.Clone(st.items),
+		missed:    st.missed,
+		negInf:    st.negInf,
+		overflow:  st.overflow,
+		posInf:    st.posInf,
+		predictor: st.predictor,
+	}
+
+	for id := range copied.slots() {
+		copied.slot(id).Quantity = 0
+	}
+
+	return copied
+}
+
+// Adds the quantities of occurrences of the other statistics with the same spans.
+//
+// On overflow of the quantity of occurrences it behaves as specified by
+// [Stat.SetOverflow], in the [OverflowError] mode the error is returned.
+func (st *Stat[Type]) merge(other *Stat[Type]) error {
+	if other.slots() != st.slots() {
+		return ErrLayoutsMismatch
+	}
+
+	for id := range st.slots() {
+		if st.slot(id).Kind != other.slot(id).Kind || st.slot(id).Span != other.slot(id).Span {
+			return ErrLayoutsMismatch
+		}
+	}
+
+	for id := range st.slots() {
+		if err := st.increase(st.slot(id), other.slot(id).Quantity); err != nil {
+			return err
+		}
 	}
 
-	st.missed.Quantity++
+	return nil
 }
 
 func (*Stat[Type]) lower() int {
@@ -157,14 +241,22 @@ func (st *Stat[Type]) Items() []Item[Type] {
 
 // Writes statistics as a bar chart to the specified writers.
 //
-// If no writer is specified, the bar chart will be written to standard output.
+// Bar chart is drawn using the pterm library. If the library is built with the
+// stat_nopterm build tag, the bar chart is drawn by [Stat.Text] and the pterm
+// library is not linked.
+//
+// If the quantity of occurrences is too large for the pterm library, bar lengths
+// are scaled proportionally and the exact quantities are displayed in the labels.
+//
+// If writers are not specified, the bar chart will be written to standard output.
+// To specify display options use [Stat.GraphWith].
 func (st *Stat[Type]) Graph(writers ...io.Writer) error {
 	if len(writers) == 0 {
-		return st.graph(os.Stdout)
+		return st.GraphWith(os.Stdout)
 	}
 
 	for _, writer := range writers {
-		if err := st.graph(writer); err != nil {
+		if err := st.GraphWith(writer); err != nil {
 			return err
 		}
 	}
@@ -172,79 +264,22 @@ func (st *Stat[Type]) Graph(writers ...io.Writer) error {
 	return nil
 }
 
-func (st *Stat[Type]) graph(writer io.Writer) error {
-	bars := make([]pterm.Bar, 0, len(st.items)+specialItemsQuantity)
-
-	style := &pterm.Style{
-		pterm.BgDefault,
-		pterm.FgDefault,
-	}
-
-	if st.missed.Quantity != 0 {
-		value, err := safe.IToI[int](st.missed.Quantity)
-		if err != nil {
-			return err
-		}
-
-		bar := pterm.Bar{
-			Label:      fmt.Sprintf("[%v]", st.missed.Kind),
-			Value:      value,
-			Style:      style,
-			LabelStyle: style,
-		}
-
-		bars = append(bars, bar)
-	}
-
-	if st.negInf.Quantity != 0 {
-		value, err := safe.IToI[int](st.negInf.Quantity)
-		if err != nil {
-			return err
-		}
-
-		bar := pterm.Bar{
-			Label:      fmt.Sprintf("[%v:%v]", st.negInf.Kind, st.negInf.Span.End),
-			Value:      value,
-			Style:      style,
-			LabelStyle: style,
-		}
-
-		bars = append(bars, bar)
-	}
-
-	for _, item := range st.items {
-		value, err := safe.IToI[int](item.Quantity)
-		if err != nil {
-			return err
-		}
-
-		bar := pterm.Bar{
-			Label:      fmt.Sprintf("[%v:%v]", item.Span.Begin, item.Span.End),
-			Value:      value,
-			Style:      style,
-			LabelStyle: style,
-		}
-
-		bars = append(bars, bar)
+// Writes statistics as a bar chart with the specified display options to the
+// specified writer.
+//
+// See [Stat.Graph] for details.
+//
+// If writer is not specified (is nil), the bar chart will be written to standard
+// output.
+func (st *Stat[Type]) GraphWith(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
 	}
 
-	if st.posInf.Quantity != 0 {
-		value, err := safe.IToI[int](st.posInf.Quantity)
-		if err != nil {
-			return err
-		}
-
-		bar := pterm.Bar{
-			Label:      fmt.Sprintf("[%v:%v]", st.posInf.Span.Begin, st.posInf.Kind),
-			Value:      value,
-			Style:      style,
-			LabelStyle: style,
-		}
-
-		bars = append(bars, bar)
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
 	}
 
-	chart := pterm.DefaultBarChart.WithBars(bars).WithShowValue()
-
-	return chart.WithWriter(writer).Render()
+	return graph(writer, chr)
 }
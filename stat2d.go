@@ -0,0 +1,296 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Two-dimensional statistics.
+type Stat2D[TypeX, TypeY constraints.Integer] struct {
+	cells    []uint64
+	err      error
+	overflow Overflow
+	x        *Stat[TypeX]
+	y        *Stat[TypeY]
+}
+
+// Creates an instance of two-dimensional statistics.
+//
+// Spans and prediction functions of dimensions are taken from the specified
+// statistics, which are used as layouts only: their quantities of occurrences are
+// not taken into account and they are not changed.
+func New2D[TypeX, TypeY constraints.Integer](x *Stat[TypeX], y *Stat[TypeY]) *Stat2D[TypeX, TypeY] {
+	st := &Stat2D[TypeX, TypeY]{
+		x:     x.layout(),
+		y:     y.layout(),
+		cells: make([]uint64, x.slots()*y.slots()),
+	}
+
+	return st
+}
+
+// Increases the quantity of occurrences of the specified pair of values.
+//
+// Values that do not belong to the spans of their dimensions are counted as
+// negative infinity, positive infinity or missed in the corresponding dimension.
+//
+// On overflow of the quantity of occurrences it behaves as specified by
+// [Stat2D.SetOverflow], in the [OverflowError] mode the error is available via
+// [Stat2D.Err].
+func (st *Stat2D[TypeX, TypeY]) Inc(x TypeX, y TypeY) {
+	_ = st.Add(x, y, 1)
+}
+
+// Increases the quantity of occurrences of the specified pair of values by the
+// specified quantity.
+//
+// Error is returned only in the [OverflowError] mode on overflow of the quantity
+// of occurrences.
+func (st *Stat2D[TypeX, TypeY]) Add(x TypeX, y TypeY, quantity uint64) error {
+	cell := &st.cells[st.cell(st.x.locate(x), st.y.locate(y))]
+
+	if err := addQuantity(cell, quantity, st.overflow); err != nil {
+		st.err = err
+		return err
+	}
+
+	return nil
+}
+
+// Sets the behavior of statistics on overflow of the quantity of occurrences.
+//
+// Behavior is applied to pairs of values and to marginal statistics.
+func (st *Stat2D[TypeX, TypeY]) SetOverflow(overflow Overflow) {
+	st.overflow = overflow
+}
+
+// Returns the [ErrQuantityOverflow] error if an overflow of the quantity of
+// occurrences has occurred in the [OverflowError] mode, otherwise returns nil.
+func (st *Stat2D[TypeX, TypeY]) Err() error {
+	return st.err
+}
+
+func (st *Stat2D[TypeX, TypeY]) cell(x, y int) int {
+	return y*st.x.slots() + x
+}
+
+// Returns the quantity of occurrences of pairs of values belonging to the
+// specified items.
+//
+// Items are specified by their kinds and spans, for example, as returned by the
+// marginal statistics. Zero is returned for items not present in the dimensions.
+func (st *Stat2D[TypeX, TypeY]) Quantity(x Item[TypeX], y Item[TypeY]) uint64 {
+	idX, found := slotOf(st.x, x)
+	if !found {
+		return 0
+	}
+
+	idY, found := slotOf(st.y, y)
+	if !found {
+		return 0
+	}
+
+	return st.cells[st.cell(idX, idY)]
+}
+
+func slotOf[Type constraints.Integer](st *Stat[Type], item Item[Type]) (int, bool) {
+	for id := range st.slots() {
+		slot := st.slot(id)
+
+		if slot.Kind == item.Kind && slot.Span == item.Span {
+			return id, true
+		}
+	}
+
+	return 0, false
+}
+
+// Returns the marginal statistics of the X dimension, that is the quantities of
+// occurrences of values of the X dimension regardless of the values of the Y
+// dimension.
+//
+// On overflow of the quantity of occurrences it behaves according to the overflow
+// mode of two-dimensional statistics, in the [OverflowError] mode the error is
+// available via [Stat.Err] of the returned statistics.
+func (st *Stat2D[TypeX, TypeY]) MarginalX() *Stat[TypeX] {
+	marginal := st.x.layout()
+	marginal.SetOverflow(st.overflow)
+
+	for y := range st.y.slots() {
+		for x := range st.x.slots() {
+			_ = marginal.increase(marginal.slot(x), st.cells[st.cell(x, y)])
+		}
+	}
+
+	return marginal
+}
+
+// Returns the marginal statistics of the Y dimension, that is the quantities of
+// occurrences of values of the Y dimension regardless of the values of the X
+// dimension.
+//
+// See [Stat2D.MarginalX] for details of overflow handling.
+func (st *Stat2D[TypeX, TypeY]) MarginalY() *Stat[TypeY] {
+	marginal := st.y.layout()
+	marginal.SetOverflow(st.overflow)
+
+	for y := range st.y.slots() {
+		for x := range st.x.slots() {
+			_ = marginal.increase(marginal.slot(y), st.cells[st.cell(x, y)])
+		}
+	}
+
+	return marginal
+}
+
+// Returns the indices of slots of displayed items in the order of the list of
+// statistics items. Special items are displayed only if they are not empty.
+func displayedSlots[Type constraints.Integer](marginal *Stat[Type]) []int {
+	missed := marginal.slots() - 1
+	posInf := missed - 1
+
+	ids := make([]int, 0, marginal.slots())
+
+	if marginal.slot(missed).Quantity != 0 {
+		ids = append(ids, missed)
+	}
+
+	for id := range posInf {
+		if id == 0 && marginal.slot(id).Quantity == 0 {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	if marginal.slot(posInf).Quantity != 0 {
+		ids = append(ids, posInf)
+	}
+
+	return ids
+}
+
+// Writes statistics as a heatmap to the specified writer.
+//
+// Rows of the heatmap correspond to the items of the Y dimension, columns
+// correspond to the items of the X dimension. Each cell is represented by one
+// character whose shade is proportional to the quantity of occurrences. Empty
+// cells are represented by a space. Special items are displayed only if they
+// are not empty.
+//
+// Only the [WithASCII] and [WithLogarithmic] options are used.
+//
+// If writer is not specified (is nil), the heatmap will be written to standard
+// output.
+func (st *Stat2D[TypeX, TypeY]) Heatmap(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	options, err := newGraphOpts(opts)
+	if err != nil {
+		return err
+	}
+
+	columns := displayedSlots(st.MarginalX())
+	rows := displayedSlots(st.MarginalY())
+
+	magnitude := func(quantity uint64) float64 {
+		if options.logarithmic {
+			return math.Log1p(float64(quantity))
+		}
+
+		return float64(quantity)
+	}
+
+	maximum := uint64(0)
+
+	for _, y := range rows {
+		for _, x := range columns {
+			maximum = max(maximum, st.cells[st.cell(x, y)])
+		}
+	}
+
+	names := make([]string, len(rows))
+	nameWidth := 0
+
+	for id, y := range rows {
+		names[id] = itemLabel(*st.y.slot(y))
+		nameWidth = max(nameWidth, utf8.RuneCountInString(names[id]))
+	}
+
+	shades := []rune(heatmapShades)
+	axis := []rune(columnAxis)
+
+	if options.ascii {
+		shades = []rune(asciiLevels)
+		axis = []rune(asciiColumnAxis)
+	}
+
+	builder := new(strings.Builder)
+
+	if options.logarithmic {
+		builder.WriteString(logarithmicMarker)
+		builder.WriteString("\n")
+	}
+
+	for id, y := range rows {
+		builder.WriteString(names[id])
+		builder.WriteString(pad(nameWidth - utf8.RuneCountInString(names[id])))
+		builder.WriteString(" ")
+		builder.WriteRune(axis[0])
+
+		line := new(strings.Builder)
+
+		for _, x := range columns {
+			level := barUnits(magnitude(st.cells[st.cell(x, y)]), magnitude(maximum), len(shades))
+
+			if level == 0 {
+				line.WriteString(" ")
+				continue
+			}
+
+			line.WriteRune(shades[level-1])
+		}
+
+		builder.WriteString(strings.TrimRight(line.String(), " "))
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString(pad(nameWidth + 1))
+	builder.WriteRune(axis[1])
+	builder.WriteString(strings.Repeat(string(axis[2]), len(columns)))
+	builder.WriteString("\n")
+
+	// Regular items are always displayed, so there is at least one column
+	first := itemLabel(*st.x.slot(columns[0]))
+	last := itemLabel(*st.x.slot(columns[len(columns)-1]))
+
+	builder.WriteString(pad(nameWidth + 2))
+	builder.WriteString(first)
+
+	if len(columns) > 1 {
+		gap := len(columns) - utf8.RuneCountInString(first) - utf8.RuneCountInString(last)
+
+		builder.WriteString(pad(max(1, gap)))
+		builder.WriteString(last)
+	}
+
+	builder.WriteString("\n")
+
+	builder.WriteString(pad(nameWidth + 2))
+	builder.WriteRune(shades[len(shades)-1])
+	builder.WriteString(" ")
+	builder.WriteString(strconv.FormatUint(maximum, decimalBase))
+	builder.WriteString("\n")
+
+	_, err = io.WriteString(writer, builder.String())
+
+	return err
+}
@@ -0,0 +1,160 @@
+package stat
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func newStat2DTest(t *testing.T) *Stat2D[int, uint8] {
+	t.Helper()
+
+	x, err := New(
+		[]span.Span[int]{
+			{Begin: 1, End: 10},
+			{Begin: 11, End: 20},
+			{Begin: 31, End: 40},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	y, err := NewLinear[uint8](0, 99, 25)
+	require.NoError(t, err)
+
+	x.Inc(1)
+	y.Inc(1)
+
+	st := New2D(x, y)
+
+	st.Inc(1, 0)
+	st.Inc(1, 10)
+	st.Inc(12, 30)
+	st.Inc(12, 30)
+	st.Inc(12, 30)
+	st.Inc(35, 99)
+	st.Inc(0, 200)
+	st.Inc(25, 60)
+	st.Inc(50, 60)
+
+	// Layouts are not changed
+	require.Equal(t, uint64(1), x.Items()[0].Quantity)
+	require.Equal(t, uint64(1), y.Items()[0].Quantity)
+
+	return st
+}
+
+func TestStat2DMarginal(t *testing.T) {
+	st := newStat2DTest(t)
+
+	expectedX := []Item[int]{
+		{Kind: ItemKindMissed, Quantity: 1},
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 3, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[int]{Begin: 31, End: 40}},
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[int]{Begin: 41, End: math.MaxInt}},
+	}
+
+	expectedY := []Item[uint8]{
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[uint8]{Begin: 0, End: 24}},
+		{Kind: ItemKindRegular, Quantity: 3, Span: span.Span[uint8]{Begin: 25, End: 49}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[uint8]{Begin: 50, End: 74}},
+		{Kind: ItemKindRegular, Quantity: 1, Span: span.Span[uint8]{Begin: 75, End: 99}},
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[uint8]{Begin: 100, End: 255}},
+	}
+
+	require.Equal(t, expectedX, st.MarginalX().Items())
+	require.Equal(t, expectedY, st.MarginalY().Items())
+}
+
+func TestStat2DQuantity(t *testing.T) {
+	st := newStat2DTest(t)
+
+	x := st.MarginalX().Items()
+	y := st.MarginalY().Items()
+
+	require.Equal(t, uint64(3), st.Quantity(x[3], y[1]))
+	require.Equal(t, uint64(1), st.Quantity(x[1], y[4]))
+	require.Equal(t, uint64(1), st.Quantity(x[0], y[2]))
+	require.Zero(t, st.Quantity(x[3], y[0]))
+	require.Zero(t, st.Quantity(Item[int]{Kind: ItemKindRegular}, y[0]))
+	require.Zero(t, st.Quantity(x[2], Item[uint8]{Kind: ItemKindRegular, Span: span.Span[uint8]{End: 1}}))
+}
+
+func TestStat2DHeatmap(t *testing.T) {
+	st := newStat2DTest(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, st.Heatmap(buffer))
+	requireGolden(t, "heatmap.golden", buffer.String())
+
+	buffer.Reset()
+
+	require.NoError(t, st.Heatmap(buffer, WithASCII(), WithLogarithmic()))
+	requireGolden(t, "heatmap_ascii.golden", buffer.String())
+}
+
+func TestStat2DHeatmapSingle(t *testing.T) {
+	x, err := NewLinear(1, 10, 10)
+	require.NoError(t, err)
+
+	st := New2D(x, x)
+
+	st.Inc(1, 1)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, st.Heatmap(buffer))
+	require.Equal(t, "[1:10] │█\n       └─\n        [1:10]\n        █ 1\n", buffer.String())
+}
+
+func TestStat2DHeatmapError(t *testing.T) {
+	st := newStat2DTest(t)
+
+	require.Error(t, st.Heatmap(nil, WithWidth(-1)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, st.Heatmap(nil))
+
+	os.Stdout = stdout
+}
+
+func TestStat2DOverflow(t *testing.T) {
+	x, err := NewLinear(1, 10, 5)
+	require.NoError(t, err)
+
+	y, err := NewLinear[uint8](1, 10, 5)
+	require.NoError(t, err)
+
+	st := New2D(x, y)
+
+	require.NoError(t, st.Add(1, 1, math.MaxUint64))
+	st.Inc(1, 1)
+	require.Zero(t, st.cells[st.cell(1, 1)])
+
+	st.SetOverflow(OverflowSaturate)
+
+	require.NoError(t, st.Add(1, 1, math.MaxUint64))
+	require.NoError(t, st.Add(1, 6, math.MaxUint64))
+	st.Inc(1, 1)
+	require.Equal(t, uint64(math.MaxUint64), st.cells[st.cell(1, 1)])
+	require.Equal(t, uint64(math.MaxUint64), st.MarginalX().items[0].Quantity)
+	require.NoError(t, st.MarginalX().Err())
+
+	st.SetOverflow(OverflowError)
+
+	require.ErrorIs(t, st.Add(1, 1, 1), ErrQuantityOverflow)
+	require.NoError(t, st.Add(11, 11, 1))
+	require.ErrorIs(t, st.Err(), ErrQuantityOverflow)
+	require.Equal(t, uint64(math.MaxUint64), st.cells[st.cell(1, 1)])
+	require.ErrorIs(t, st.MarginalX().Err(), ErrQuantityOverflow)
+	require.NoError(t, st.MarginalY().Err())
+}
@@ -226,32 +226,18 @@ func TestStatGraphError(t *testing.T) {
 	stat, err := New([]span.Span[int]{{Begin: 0, End: 0}}, nil)
 	require.NoError(t, err)
 
-	stat.missed.Quantity = math.MaxUint64
-	stat.negInf.Quantity = 0
-	stat.items[0].Quantity = 0
-	stat.posInf.Quantity = 0
-	require.Error(t, stat.Graph(io.Discard))
-
-	stat.missed.Quantity = 0
-	stat.negInf.Quantity = math.MaxUint64
-	stat.items[0].Quantity = 0
-	stat.posInf.Quantity = 0
-	require.Error(t, stat.Graph(io.Discard))
-
-	stat.negInf.Quantity = 0
-	stat.items[0].Quantity = math.MaxUint64
-	stat.posInf.Quantity = 0
-	require.Error(t, stat.Graph(io.Discard))
-
-	stat.negInf.Quantity = 0
-	stat.items[0].Quantity = 0
-	stat.posInf.Quantity = math.MaxUint64
-	require.Error(t, stat.Graph(io.Discard))
+	require.ErrorIs(t, stat.GraphWith(io.Discard, WithTop(-1)), ErrTopNegative)
+	require.ErrorIs(
+		t,
+		stat.GraphWith(io.Discard, WithLabelFormatter(func(Item[uint]) string { return "" })),
+		ErrLabelFormatterMismatch,
+	)
 
 	stdout := os.Stdout
 	os.Stdout = nil
 
 	require.Error(t, stat.Graph())
+	require.Error(t, stat.GraphWith(nil))
 
 	os.Stdout = stdout
 }
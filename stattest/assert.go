@@ -0,0 +1,182 @@
+package stattest
+
+import (
+	"math"
+	"strings"
+
+	"github.com/akramarenkov/stat"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Interface of the test used by assertions, it is implemented by [testing.T],
+// [testing.B] and [testing.F].
+type TestingT interface {
+	Errorf(format string, args ...any)
+	Helper()
+}
+
+// Asserts that the specified quantile of statistics is within the range
+// [lower, upper].
+//
+// Returns true if the assertion is passed. On failure, the error message
+// includes the bar chart of statistics.
+func QuantileWithin[Type constraints.Integer](
+	t TestingT,
+	st *stat.Stat[Type],
+	quantile float64,
+	lower Type,
+	upper Type,
+) bool {
+	t.Helper()
+
+	value, err := st.Quantile(quantile)
+	if err != nil {
+		return fail(t, st, "failed to calculate quantile %v: %v", quantile, err)
+	}
+
+	if value < lower || value > upper {
+		return fail(t, st, "quantile %v is equal to %v, expected to be within [%v, %v]", quantile, value, lower, upper)
+	}
+
+	return true
+}
+
+// Asserts that statistics has no occurrences in the -Inf, +Inf and missed items.
+//
+// Returns true if the assertion is passed. On failure, the error message
+// includes the bar chart of statistics.
+func NoOutliers[Type constraints.Integer](t TestingT, st *stat.Stat[Type]) bool {
+	t.Helper()
+
+	summary := st.Summary()
+
+	if summary.NegInf == 0 && summary.PosInf == 0 && summary.Missed == 0 {
+		return true
+	}
+
+	return fail(
+		t,
+		st,
+		"statistics has outliers: %v in -Inf, %v in +Inf, %v missed",
+		summary.NegInf,
+		summary.PosInf,
+		summary.Missed,
+	)
+}
+
+// Asserts that the distribution of statistics is approximately uniform over the
+// range of regular items at the specified significance level.
+//
+// Uniformity is tested by the chi-square goodness-of-fit test, expected quantity
+// of occurrences of each item is proportional to the width of its span, so any
+// occurrences in the -Inf and +Inf items fail the assertion. Assertion fails if
+// the p-value of the test is less than alpha.
+//
+// Returns true if the assertion is passed. On failure, the error message
+// includes the bar chart of statistics.
+func Uniform[Type constraints.Integer](t TestingT, st *stat.Stat[Type], alpha float64) bool {
+	t.Helper()
+
+	probability := func(item stat.Item[Type]) float64 {
+		if item.Kind != stat.ItemKindRegular {
+			return 0
+		}
+
+		return float64(item.Span.End) - float64(item.Span.Begin) + 1
+	}
+
+	return fit(t, st, "uniform", probability, alpha)
+}
+
+// Asserts that the distribution of statistics is approximately normal with the
+// specified mean and standard deviation at the specified significance level.
+//
+// Normality is tested by the chi-square goodness-of-fit test, expected quantity
+// of occurrences of each item (including -Inf and +Inf items) is calculated from
+// the normal distribution with continuity correction. Assertion fails if the
+// p-value of the test is less than alpha.
+//
+// Returns true if the assertion is passed. On failure, the error message
+// includes the bar chart of statistics.
+func Normal[Type constraints.Integer](
+	t TestingT,
+	st *stat.Stat[Type],
+	mean float64,
+	stddev float64,
+	alpha float64,
+) bool {
+	t.Helper()
+
+	if stddev <= 0 || math.IsNaN(stddev) || math.IsInf(stddev, 0) {
+		return fail(t, st, "standard deviation %v is not positive or not finite", stddev)
+	}
+
+	// Cumulative distribution function of the normal distribution
+	cdf := func(value float64) float64 {
+		return math.Erfc(-(value-mean)/(stddev*math.Sqrt2)) / erfcRange
+	}
+
+	probability := func(item stat.Item[Type]) float64 {
+		lower := cdf(float64(item.Span.Begin) - continuityCorrection)
+		upper := cdf(float64(item.Span.End) + continuityCorrection)
+
+		switch item.Kind {
+		case stat.ItemKindNegInf:
+			return upper
+		case stat.ItemKindPosInf:
+			return 1 - lower
+		}
+
+		return upper - lower
+	}
+
+	return fit(t, st, "normal", probability, alpha)
+}
+
+func fit[Type constraints.Integer](
+	t TestingT,
+	st *stat.Stat[Type],
+	distribution string,
+	probability func(item stat.Item[Type]) float64,
+	alpha float64,
+) bool {
+	t.Helper()
+
+	if alpha <= 0 || alpha >= 1 || math.IsNaN(alpha) {
+		return fail(t, st, "significance level %v is not in the range (0, 1)", alpha)
+	}
+
+	result, err := stat.GoodnessOfFit(st, probability)
+	if err != nil {
+		return fail(t, st, "failed to test distribution to be %s: %v", distribution, err)
+	}
+
+	if result.PValue < alpha {
+		return fail(
+			t,
+			st,
+			"distribution is not %s: chi-square statistic %.4g, p-value %.4g < %v",
+			distribution,
+			result.Statistic,
+			result.PValue,
+			alpha,
+		)
+	}
+
+	return true
+}
+
+func fail[Type constraints.Integer](t TestingT, st *stat.Stat[Type], format string, args ...any) bool {
+	t.Helper()
+
+	graph := new(strings.Builder)
+
+	if err := st.Text(graph, stat.WithPercentage()); err != nil {
+		graph.WriteString(err.Error())
+	}
+
+	t.Errorf(format+"\n%s", append(args, graph.String())...)
+
+	return false
+}
@@ -0,0 +1,141 @@
+package stattest
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/akramarenkov/stat"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeT struct {
+	errors []string
+}
+
+func (ft *fakeT) Errorf(format string, args ...any) {
+	ft.errors = append(ft.errors, fmt.Sprintf(format, args...))
+}
+
+func (*fakeT) Helper() {}
+
+func newUniformStat(t *testing.T) *stat.Stat[int] {
+	t.Helper()
+
+	st, err := stat.NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	for range 10 {
+		for value := 1; value <= 100; value++ {
+			st.Inc(value)
+		}
+	}
+
+	return st
+}
+
+func newNormalStat(t *testing.T) *stat.Stat[int] {
+	t.Helper()
+
+	st, err := stat.NewLinear(1, 100, 5)
+	require.NoError(t, err)
+
+	//nolint:gosec // Deterministic sequence is required
+	random := rand.New(rand.NewPCG(1, 2))
+
+	for range 10000 {
+		st.Inc(int(math.Round(random.NormFloat64()*10 + 50)))
+	}
+
+	return st
+}
+
+func TestQuantileWithin(t *testing.T) {
+	st := newUniformStat(t)
+
+	require.True(t, QuantileWithin(t, st, 0.5, 45, 55))
+
+	ft := &fakeT{}
+
+	require.False(t, QuantileWithin(ft, st, 0.5, 60, 70))
+	require.False(t, QuantileWithin(ft, st, 2, 0, 100))
+	require.Len(t, ft.errors, 2)
+	require.Contains(t, ft.errors[0], "quantile 0.5 is equal to")
+	require.Contains(t, ft.errors[0], "[91:100]")
+	require.Contains(t, ft.errors[1], stat.ErrQuantileInvalid.Error())
+}
+
+func TestNoOutliers(t *testing.T) {
+	st := newUniformStat(t)
+
+	require.True(t, NoOutliers(t, st))
+
+	st.Inc(0)
+	st.Inc(101)
+	st.Inc(102)
+
+	ft := &fakeT{}
+
+	require.False(t, NoOutliers(ft, st))
+	require.Len(t, ft.errors, 1)
+	require.Contains(t, ft.errors[0], "1 in -Inf, 2 in +Inf, 0 missed")
+	require.Contains(t, ft.errors[0], "[101:+Inf]")
+}
+
+func TestUniform(t *testing.T) {
+	st := newUniformStat(t)
+
+	require.True(t, Uniform(t, st, 0.05))
+
+	for range 100 {
+		st.Inc(1)
+	}
+
+	ft := &fakeT{}
+
+	require.False(t, Uniform(ft, st, 0.05))
+	require.False(t, Uniform(ft, st, 0))
+	require.Len(t, ft.errors, 2)
+	require.Contains(t, ft.errors[0], "distribution is not uniform")
+	require.Contains(t, ft.errors[0], "[1:10]")
+	require.Contains(t, ft.errors[1], "significance level 0 is not in the range")
+}
+
+func TestUniformOutliers(t *testing.T) {
+	st := newUniformStat(t)
+
+	st.Inc(0)
+
+	ft := &fakeT{}
+
+	require.False(t, Uniform(ft, st, 0.05))
+	require.Len(t, ft.errors, 1)
+	require.Contains(t, ft.errors[0], "p-value 0 < 0.05")
+}
+
+func TestNormal(t *testing.T) {
+	st := newNormalStat(t)
+
+	require.True(t, Normal(t, st, 50, 10, 0.01))
+
+	ft := &fakeT{}
+
+	require.False(t, Normal(ft, st, 60, 10, 0.01))
+	require.False(t, Normal(ft, st, 50, 0, 0.01))
+	require.Len(t, ft.errors, 2)
+	require.Contains(t, ft.errors[0], "distribution is not normal")
+	require.Contains(t, ft.errors[1], "standard deviation 0 is not positive")
+}
+
+func TestNormalEmpty(t *testing.T) {
+	st, err := stat.NewLinear(1, 100, 5)
+	require.NoError(t, err)
+
+	ft := &fakeT{}
+
+	require.False(t, Normal(ft, st, 50, 10, 0.01))
+	require.Len(t, ft.errors, 1)
+	require.Contains(t, ft.errors[0], stat.ErrNoOccurrences.Error())
+}
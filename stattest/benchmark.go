@@ -0,0 +1,138 @@
+package stattest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/stat"
+)
+
+// Option of the benchmark recorder.
+type Option func(rec *Recorder)
+
+// Enables logging of the bar chart of durations to the benchmark log when the
+// metrics are reported. Bar chart is written by [stat.Stat.GraphWith] with the
+// specified options.
+func WithGraph(opts ...stat.GraphOption) Option {
+	return func(rec *Recorder) {
+		rec.graph = true
+		rec.graphOpts = opts
+	}
+}
+
+// Records durations of benchmark operations into statistics and reports their
+// quantiles as benchmark metrics.
+//
+// Reported metrics are p50-ns/op, p90-ns/op, p99-ns/op and max-ns/op. Quantiles
+// are estimated from statistics, so their accuracy depends on its spans, the
+// maximum is exact.
+type Recorder struct {
+	b         *testing.B
+	graph     bool
+	graphOpts []stat.GraphOption
+	last      time.Time
+	maximum   time.Duration
+	running   bool
+	stat      *stat.Stat[time.Duration]
+}
+
+// Creates a benchmark recorder that records durations into the specified
+// statistics.
+func NewRecorder(b *testing.B, st *stat.Stat[time.Duration], opts ...Option) *Recorder {
+	rec := &Recorder{
+		b:    b,
+		stat: st,
+	}
+
+	for _, opt := range opts {
+		opt(rec)
+	}
+
+	return rec
+}
+
+// Wraps [testing.B.Loop] and records the duration of each iteration.
+//
+// When the loop ends, the metrics are reported. Usage:
+//
+//	rec := stattest.NewRecorder(b, st)
+//
+//	for rec.Loop() {
+//		operation()
+//	}
+func (rec *Recorder) Loop() bool {
+	if rec.running {
+		rec.Record(time.Since(rec.last))
+	}
+
+	if !rec.b.Loop() {
+		rec.running = false
+		rec.Report()
+
+		return false
+	}
+
+	rec.running = true
+	rec.last = time.Now()
+
+	return true
+}
+
+// Measures and records the duration of the specified operation.
+//
+// It is intended for benchmarks that iterate up to [testing.B.N], metrics must be
+// reported by [Recorder.Report] after the iterations.
+func (rec *Recorder) Time(operation func()) {
+	begin := time.Now()
+
+	operation()
+
+	rec.Record(time.Since(begin))
+}
+
+// Records the specified duration.
+func (rec *Recorder) Record(duration time.Duration) {
+	rec.stat.Inc(duration)
+	rec.maximum = max(rec.maximum, duration)
+}
+
+// Reports the metrics and, if enabled, logs the bar chart of durations.
+//
+// Metrics are not reported if no durations have been recorded.
+func (rec *Recorder) Report() {
+	rec.b.Helper()
+
+	quantiles := []struct {
+		quantile float64
+		unit     string
+	}{
+		{quantile: 0.5, unit: "p50-ns/op"},
+		{quantile: 0.9, unit: "p90-ns/op"},
+		{quantile: 0.99, unit: "p99-ns/op"},
+	}
+
+	for _, quantile := range quantiles {
+		value, err := rec.stat.Quantile(quantile.quantile)
+		if err != nil {
+			return
+		}
+
+		rec.b.ReportMetric(float64(value.Nanoseconds()), quantile.unit)
+	}
+
+	rec.b.ReportMetric(float64(rec.maximum.Nanoseconds()), "max-ns/op")
+
+	if !rec.graph {
+		return
+	}
+
+	builder := new(strings.Builder)
+
+	if err := rec.stat.GraphWith(builder, rec.graphOpts...); err != nil {
+		rec.b.Error(err)
+		return
+	}
+
+	rec.b.Log("\n" + builder.String())
+}
@@ -0,0 +1,65 @@
+package stattest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/akramarenkov/stat"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newDurationStat(t testing.TB) *stat.Stat[time.Duration] {
+	st, err := stat.NewLinear(0, time.Millisecond, 10*time.Microsecond)
+	require.NoError(t, err)
+
+	return st
+}
+
+func TestRecorderLoop(t *testing.T) {
+	st := newDurationStat(t)
+
+	result := testing.Benchmark(func(b *testing.B) {
+		rec := NewRecorder(b, st, WithGraph(stat.WithCollapse()))
+
+		for rec.Loop() {
+			time.Sleep(time.Microsecond)
+		}
+	})
+
+	require.NotZero(t, result.N)
+	require.Equal(t, uint64(result.N), st.Summary().Total)
+
+	for _, unit := range []string{"p50-ns/op", "p90-ns/op", "p99-ns/op", "max-ns/op"} {
+		require.Contains(t, result.Extra, unit)
+		require.Positive(t, result.Extra[unit], unit)
+	}
+
+	require.LessOrEqual(t, result.Extra["p50-ns/op"], result.Extra["p99-ns/op"])
+}
+
+func TestRecorderTime(t *testing.T) {
+	result := testing.Benchmark(func(b *testing.B) {
+		rec := NewRecorder(b, newDurationStat(b))
+
+		for range b.N {
+			rec.Time(func() {})
+		}
+
+		rec.Record(2 * time.Second)
+		rec.Report()
+	})
+
+	require.InDelta(t, float64(2*time.Second), result.Extra["max-ns/op"], 0)
+	require.InDelta(t, float64(0), result.Extra["p50-ns/op"], float64(10*time.Microsecond))
+}
+
+func TestRecorderEmpty(t *testing.T) {
+	result := testing.Benchmark(func(b *testing.B) {
+		rec := NewRecorder(b, newDurationStat(b), WithGraph(stat.WithWidth(-1)))
+
+		rec.Report()
+	})
+
+	require.Empty(t, result.Extra)
+}
@@ -0,0 +1,6 @@
+package stattest
+
+const (
+	continuityCorrection = 0.5
+	erfcRange            = 2 // Complementary error function takes values in [0, 2]
+)
@@ -0,0 +1,2 @@
+// Package stattest provides helpers for using statistics in tests and benchmarks.
+package stattest
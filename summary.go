@@ -0,0 +1,94 @@
+package stat
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Summary of statistics.
+type Summary[Type constraints.Integer] struct {
+	// Total quantity of occurrences, including missed ones
+	Total uint64
+
+	// Quantity of occurrences of values not belonging to any span
+	Missed uint64
+
+	// Quantity of occurrences of values less than the beginning of the first span
+	NegInf uint64
+
+	// Quantity of occurrences of values greater than the end of the last span
+	PosInf uint64
+
+	// Lower bound of the occurred values, that is the beginning of the span of the
+	// first non-empty regular item. Occurrences in special items are not taken
+	// into account
+	Min Type
+
+	// Upper bound of the occurred values, that is the end of the span of the last
+	// non-empty regular item. Occurrences in special items are not taken into
+	// account
+	Max Type
+
+	// Estimate of the mean of the occurred values calculated from the midpoints of
+	// the spans of regular items. Occurrences in special items are not taken into
+	// account
+	Mean float64
+}
+
+// Returns a summary of statistics.
+func (st *Stat[Type]) Summary() Summary[Type] {
+	return summarize(st.Items())
+}
+
+func summarize[Type constraints.Integer](items []Item[Type]) Summary[Type] {
+	summary := Summary[Type]{}
+
+	found := false
+	sum := 0.0
+	regular := 0.0
+
+	for _, item := range items {
+		summary.Total = addSat(summary.Total, item.Quantity)
+
+		switch item.Kind {
+		case ItemKindMissed:
+			summary.Missed = item.Quantity
+		case ItemKindNegInf:
+			summary.NegInf = item.Quantity
+		case ItemKindPosInf:
+			summary.PosInf = item.Quantity
+		case ItemKindRegular:
+			midpoint := (float64(item.Span.Begin) + float64(item.Span.End)) / 2
+
+			sum += midpoint * float64(item.Quantity)
+			regular += float64(item.Quantity)
+
+			if item.Quantity == 0 {
+				continue
+			}
+
+			if !found {
+				summary.Min = item.Span.Begin
+				found = true
+			}
+
+			summary.Max = item.Span.End
+		}
+	}
+
+	if regular != 0 {
+		summary.Mean = sum / regular
+	}
+
+	return summary
+}
+
+// Returns true if there are occurrences of values in regular items.
+func hasRegularOccurrences[Type constraints.Integer](items []Item[Type]) bool {
+	for _, item := range items {
+		if item.Kind == ItemKindRegular && item.Quantity != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package stat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatSummary(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	expected := Summary[int]{
+		Total:  10,
+		NegInf: 1,
+		Min:    1,
+		Max:    60,
+		Mean:   (3*5.5 + 2*45.5 + 4*55.5) / 9,
+	}
+
+	require.Equal(t, expected, stat.Summary())
+
+	stat.Inc(100)
+	stat.missed.Quantity = 2
+
+	expected = Summary[int]{
+		Total:  13,
+		Missed: 2,
+		NegInf: 1,
+		PosInf: 1,
+		Min:    1,
+		Max:    60,
+		Mean:   (3*5.5 + 2*45.5 + 4*55.5) / 9,
+	}
+
+	require.Equal(t, expected, stat.Summary())
+}
+
+func TestStatSummaryRegular(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	stat.Inc(15)
+	stat.Inc(35)
+
+	expected := Summary[int]{
+		Total: 2,
+		Min:   11,
+		Max:   40,
+		Mean:  25.5,
+	}
+
+	require.Equal(t, expected, stat.Summary())
+}
+
+func TestStatSummarySpecial(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	stat.Inc(0)
+	stat.Inc(101)
+
+	expected := Summary[int]{
+		Total:  2,
+		NegInf: 1,
+		PosInf: 1,
+	}
+
+	require.Equal(t, expected, stat.Summary())
+}
+
+func TestStatSummaryEmpty(t *testing.T) {
+	stat, err := NewLinear(1, 100, 10)
+	require.NoError(t, err)
+
+	require.Equal(t, Summary[int]{}, stat.Summary())
+}
@@ -0,0 +1,240 @@
+package stat
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a standalone SVG image of a bar chart to the specified
+// writer.
+//
+// Missed, negative and positive infinity items are drawn in distinct colors.
+// Horizontal axis is labeled with the spans of items, vertical axis is labeled
+// with the maximum quantity of occurrences. Each bar has a tooltip with the label
+// and the quantity of occurrences of the item and data attributes (data-span,
+// data-quantity and data-percentage) for use by scripts.
+//
+// The size of the image is set by the [WithImageSize] option, the quantile
+// markers are enabled by the [WithQuantiles] option.
+//
+// If writer is not specified (is nil), the image will be written to standard
+// output.
+func (st *Stat[Type]) WriteSVG(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return writeSVG(writer, chr)
+}
+
+func writeSVG[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	geo := newGeometry(chr)
+
+	builder := new(strings.Builder)
+
+	fmt.Fprintf(
+		builder,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		chr.opts.imageWidth,
+		chr.opts.imageHeight,
+		chr.opts.imageWidth,
+		chr.opts.imageHeight,
+	)
+
+	writeSVGStyle(builder, chr.opts.palette)
+
+	fmt.Fprintf(
+		builder,
+		`<rect class="background" width="%d" height="%d"/>`+"\n",
+		chr.opts.imageWidth,
+		chr.opts.imageHeight,
+	)
+
+	if chr.opts.logarithmic {
+		fmt.Fprintf(
+			builder,
+			`<text class="scale" x="%s" y="%s">%s</text>`+"\n",
+			svgNumber(geo.left),
+			svgNumber(geo.top/2),
+			logarithmicMarker,
+		)
+	}
+
+	writeSVGAxes(builder, chr, geo)
+
+	for id, ntr := range chr.entries {
+		writeSVGBar(builder, chr, geo, id, ntr)
+	}
+
+	for _, mrk := range chr.markers {
+		x := geo.position(mrk.id, mrk.fraction)
+
+		fmt.Fprintf(
+			builder,
+			`<line class="quantile" x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n",
+			svgNumber(x),
+			svgNumber(geo.top),
+			svgNumber(x),
+			svgNumber(geo.base()),
+		)
+
+		fmt.Fprintf(
+			builder,
+			`<text class="quantile-label" x="%s" y="%s" text-anchor="middle">%s</text>`+"\n",
+			svgNumber(x),
+			svgNumber(geo.top-svgTextIndent),
+			quantileLabel(mrk.quantile),
+		)
+	}
+
+	builder.WriteString("</svg>\n")
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+func writeSVGStyle(builder *strings.Builder, palette Palette) {
+	builder.WriteString("<style>\n")
+
+	fmt.Fprintf(
+		builder,
+		"text{font-family:sans-serif;font-size:11px;fill:%s}\n",
+		hexColor(palette.Foreground),
+	)
+
+	fmt.Fprintf(builder, ".background{fill:%s}\n", hexColor(palette.Background))
+	fmt.Fprintf(builder, ".axis{stroke:%s;stroke-width:1}\n", hexColor(palette.Foreground))
+	fmt.Fprintf(builder, ".regular{fill:%s}\n", hexColor(palette.Regular))
+	fmt.Fprintf(builder, ".missed{fill:%s}\n", hexColor(palette.Missed))
+	fmt.Fprintf(builder, ".neg-inf{fill:%s}\n", hexColor(palette.NegInf))
+	fmt.Fprintf(builder, ".pos-inf{fill:%s}\n", hexColor(palette.PosInf))
+
+	fmt.Fprintf(
+		builder,
+		".quantile{stroke:%s;stroke-width:1.5;stroke-dasharray:4 3}\n",
+		hexColor(palette.Quantile),
+	)
+
+	fmt.Fprintf(builder, ".quantile-label{fill:%s}\n", hexColor(palette.Quantile))
+
+	builder.WriteString(".scale{font-style:italic}\n")
+	builder.WriteString("</style>\n")
+}
+
+func writeSVGAxes[Type constraints.Integer](builder *strings.Builder, chr chart[Type], geo geometry) {
+	fmt.Fprintf(
+		builder,
+		`<line class="axis" x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n",
+		svgNumber(geo.left),
+		svgNumber(geo.top),
+		svgNumber(geo.left),
+		svgNumber(geo.base()),
+	)
+
+	fmt.Fprintf(
+		builder,
+		`<line class="axis" x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n",
+		svgNumber(geo.left),
+		svgNumber(geo.base()),
+		svgNumber(geo.left+geo.plotWidth()),
+		svgNumber(geo.base()),
+	)
+
+	fmt.Fprintf(
+		builder,
+		`<text x="%s" y="%s" text-anchor="end">%d</text>`+"\n",
+		svgNumber(geo.left-svgTextIndent),
+		svgNumber(geo.top+svgTextIndent),
+		chr.maxQuantity(),
+	)
+
+	fmt.Fprintf(
+		builder,
+		`<text x="%s" y="%s" text-anchor="end">0</text>`+"\n",
+		svgNumber(geo.left-svgTextIndent),
+		svgNumber(geo.base()+svgTextIndent),
+	)
+}
+
+func writeSVGBar[Type constraints.Integer](
+	builder *strings.Builder,
+	chr chart[Type],
+	geo geometry,
+	id int,
+	ntr entry[Type],
+) {
+	x, width := geo.bar(id)
+	height := geo.barHeight(chr.magnitude(ntr))
+
+	fmt.Fprintf(
+		builder,
+		`<rect class="%s" x="%s" y="%s" width="%s" height="%s"`+
+			` data-span="%s" data-quantity="%d" data-percentage="%s">`+
+			`<title>%s</title></rect>`+"\n",
+		svgClass(ntr.item.Kind),
+		svgNumber(x),
+		svgNumber(geo.base()-height),
+		svgNumber(width),
+		svgNumber(height),
+		escapeXML(chr.name(ntr)),
+		ntr.quantity,
+		formatPercentage(chr.percentage(ntr)),
+		escapeXML(chr.label(ntr, true)),
+	)
+
+	labelX := x + width/2
+	labelY := geo.base() + svgLabelIndent
+
+	fmt.Fprintf(
+		builder,
+		`<text x="%s" y="%s" text-anchor="end" transform="rotate(-45 %s %s)">%s</text>`+"\n",
+		svgNumber(labelX),
+		svgNumber(labelY),
+		svgNumber(labelX),
+		svgNumber(labelY),
+		escapeXML(chr.name(ntr)),
+	)
+}
+
+func svgClass(kind ItemKind) string {
+	switch kind {
+	case ItemKindMissed:
+		return "missed"
+	case ItemKindNegInf:
+		return "neg-inf"
+	case ItemKindPosInf:
+		return "pos-inf"
+	}
+
+	return "regular"
+}
+
+func svgNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', svgPrecision, 64)
+}
+
+func escapeXML(text string) string {
+	builder := new(strings.Builder)
+
+	// Writing to strings.Builder never fails
+	_ = xml.EscapeText(builder, []byte(text))
+
+	return builder.String()
+}
+
+func quantileLabel(quantile float64) string {
+	// Limited precision hides floating point errors, e.g. for quantile 0.55
+	return "p" + strconv.FormatFloat(percent*quantile, 'g', quantileLabelPrecision, 64)
+}
@@ -0,0 +1,94 @@
+package stat
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireWellFormedXML(t *testing.T, document string) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(strings.NewReader(document))
+
+	for {
+		_, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		require.NoError(t, err)
+	}
+}
+
+func TestStatWriteSVG(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	stat.Inc(100)
+	stat.missed.Quantity = 1
+
+	buffer := new(strings.Builder)
+
+	require.NoError(
+		t,
+		stat.WriteSVG(
+			buffer,
+			WithImageSize(400, 200),
+			WithQuantiles(0.5, 0.99),
+		),
+	)
+	requireWellFormedXML(t, buffer.String())
+	requireGolden(t, "stat.svg", buffer.String())
+}
+
+func TestStatWriteSVGLogarithmic(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WriteSVG(buffer, WithLogarithmic(), WithCollapse()))
+	requireWellFormedXML(t, buffer.String())
+	require.Contains(t, buffer.String(), logarithmicMarker)
+	require.Contains(t, buffer.String(), `width="800" height="400"`)
+	require.Contains(t, buffer.String(), "<title>… 0</title>")
+}
+
+func TestStatWriteSVGEmpty(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.WriteSVG(buffer, WithQuantiles(0.5)))
+	requireWellFormedXML(t, buffer.String())
+	require.NotContains(t, buffer.String(), `class="quantile"`)
+}
+
+func TestStatWriteSVGError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.WriteSVG(io.Discard, WithImageSize(-1, 0)))
+	require.Error(t, stat.WriteSVG(io.Discard, WithImageSize(0, -1)))
+	require.Error(t, stat.WriteSVG(io.Discard, WithQuantiles(1.5)))
+	require.Error(t, stat.WriteSVG((*os.File)(nil)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.WriteSVG(nil))
+
+	os.Stdout = stdout
+}
+
+func TestQuantileLabel(t *testing.T) {
+	require.Equal(t, "p0", quantileLabel(0))
+	require.Equal(t, "p50", quantileLabel(0.5))
+	require.Equal(t, "p55", quantileLabel(0.55))
+	require.Equal(t, "p99.9", quantileLabel(0.999))
+	require.Equal(t, "p100", quantileLabel(1))
+}
@@ -0,0 +1,148 @@
+package stat
+
+import (
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Writes statistics as a text bar chart to the specified writer.
+//
+// Bars are drawn with Unicode block characters or, if the [WithASCII] option is
+// specified, with ASCII characters only. The maximum length of bars is set by
+// the [WithWidth] option.
+//
+// Unlike [Stat.Graph], it has no external dependencies.
+//
+// If writer is not specified (is nil), the bar chart will be written to standard
+// output.
+func (st *Stat[Type]) Text(writer io.Writer, opts ...GraphOption) error {
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	chr, err := newChart(st.Items(), opts)
+	if err != nil {
+		return err
+	}
+
+	return text(writer, chr)
+}
+
+func text[Type constraints.Integer](writer io.Writer, chr chart[Type]) error {
+	names := make([]string, len(chr.entries))
+	quantities := make([]string, len(chr.entries))
+	percentages := make([]string, len(chr.entries))
+
+	nameWidth := 0
+	quantityWidth := 0
+	percentageWidth := 0
+
+	for id, ntr := range chr.entries {
+		names[id] = chr.name(ntr)
+		quantities[id] = strconv.FormatUint(ntr.quantity, decimalBase)
+		percentages[id] = formatPercentage(chr.percentage(ntr))
+
+		nameWidth = max(nameWidth, utf8.RuneCountInString(names[id]))
+		quantityWidth = max(quantityWidth, len(quantities[id]))
+		percentageWidth = max(percentageWidth, len(percentages[id]))
+	}
+
+	builder := new(strings.Builder)
+
+	if chr.opts.logarithmic {
+		builder.WriteString(logarithmicMarker)
+		builder.WriteString("\n")
+	}
+
+	maximum := chr.maxMagnitude()
+
+	for id, ntr := range chr.entries {
+		bar, length := chr.textBar(ntr, maximum)
+
+		builder.WriteString(names[id])
+		builder.WriteString(pad(nameWidth - utf8.RuneCountInString(names[id])))
+		builder.WriteString(" ")
+		builder.WriteString(chr.textSeparator())
+		builder.WriteString(bar)
+		builder.WriteString(pad(chr.opts.width - length))
+		builder.WriteString(" ")
+		builder.WriteString(pad(quantityWidth - len(quantities[id])))
+		builder.WriteString(quantities[id])
+
+		if chr.opts.percentage {
+			builder.WriteString(" ")
+			builder.WriteString(pad(percentageWidth - len(percentages[id])))
+			builder.WriteString(percentages[id])
+		}
+
+		builder.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+
+	return err
+}
+
+func (chr chart[Type]) textSeparator() string {
+	if chr.opts.ascii {
+		return "|"
+	}
+
+	return "│"
+}
+
+// Returns the bar of an entry and its length in characters.
+func (chr chart[Type]) textBar(ntr entry[Type], maximum float64) (string, int) {
+	units := barUnits(chr.magnitude(ntr), maximum, chr.opts.width*chr.textUnits())
+
+	if chr.opts.ascii {
+		return strings.Repeat(asciiBlock, units), units
+	}
+
+	full := units / blockUnits
+	partial := units % blockUnits
+
+	bar := strings.Repeat(fullBlock, full)
+
+	if partial == 0 {
+		return bar, full
+	}
+
+	// Partial blocks are sorted by the quantity of units they fill
+	return bar + string([]rune(partialBlocks)[partial-1]), full + 1
+}
+
+// Returns the quantity of units (parts of a character) in a character.
+func (chr chart[Type]) textUnits() int {
+	if chr.opts.ascii {
+		return 1
+	}
+
+	return blockUnits
+}
+
+// Returns the length of a bar in units of the specified full length.
+//
+// Non-zero magnitude always corresponds to a non-zero length, so that items with
+// occurrences are distinguishable from empty ones.
+func barUnits(magnitude, maximum float64, full int) int {
+	if magnitude == 0 || maximum == 0 {
+		return 0
+	}
+
+	return max(1, int(math.Round(magnitude/maximum*float64(full))))
+}
+
+func pad(width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	return strings.Repeat(" ", width)
+}
@@ -0,0 +1,104 @@
+package stat
+
+import (
+	"math"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatText(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	expected := "" +
+		"[-Inf:0] │██▌        1\n" +
+		"[1:10]   │███████▌   3\n" +
+		"[11:20]  │           0\n" +
+		"[21:30]  │           0\n" +
+		"[31:40]  │           0\n" +
+		"[41:50]  │█████      2\n" +
+		"[51:60]  │██████████ 4\n"
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Text(buffer, WithWidth(10)))
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestStatTextASCII(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	expected := "" +
+		"[-Inf:0] |###        1 10.00%\n" +
+		"[1:10]   |########   3 30.00%\n" +
+		"...      |           0  0.00%\n" +
+		"[41:50]  |#####      2 20.00%\n" +
+		"[51:60]  |########## 4 40.00%\n"
+
+	buffer := new(strings.Builder)
+
+	require.NoError(
+		t,
+		stat.Text(buffer, WithWidth(10), WithASCII(), WithPercentage(), WithCollapse()),
+	)
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestStatTextLogarithmic(t *testing.T) {
+	stat, err := NewLinear(1, 30, 10)
+	require.NoError(t, err)
+
+	stat.items[0].Quantity = 1e6
+	stat.items[1].Quantity = 3
+	stat.items[2].Quantity = math.MaxUint64
+
+	expected := "" +
+		"Logarithmic scale\n" +
+		"[1:10]  │██████████                                    1000000\n" +
+		"[11:20] │█                                                   3\n" +
+		"[21:30] │████████████████████████████████ 18446744073709551615\n"
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Text(buffer, WithWidth(32), WithLogarithmic()))
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestStatTextDefaultWidth(t *testing.T) {
+	stat, err := NewLinear(1, 20, 10)
+	require.NoError(t, err)
+
+	stat.Inc(1)
+
+	expected := "" +
+		"[1:10]  │" + strings.Repeat("█", defaultWidth) + " 1\n" +
+		"[11:20] │" + strings.Repeat(" ", defaultWidth) + " 0\n"
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, stat.Text(buffer))
+	require.Equal(t, expected, buffer.String())
+}
+
+func TestStatTextError(t *testing.T) {
+	stat := newGraphTestStat(t)
+
+	require.Error(t, stat.Text(nil, WithWidth(-1)))
+
+	stdout := os.Stdout
+	os.Stdout = nil
+
+	require.Error(t, stat.Text(nil))
+
+	os.Stdout = stdout
+}
+
+func TestBarUnits(t *testing.T) {
+	require.Zero(t, barUnits(0, 10, 80))
+	require.Zero(t, barUnits(10, 0, 80))
+	require.Equal(t, 1, barUnits(1e-6, 10, 80))
+	require.Equal(t, 40, barUnits(5, 10, 80))
+	require.Equal(t, 80, barUnits(10, 10, 80))
+}
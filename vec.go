@@ -0,0 +1,208 @@
+package stat
+
+import (
+	"io"
+	"slices"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Vector of statistics identified by keys, for example, by HTTP routes.
+//
+// Statistics are created on first use of the key. All methods are safe for
+// concurrent use.
+type Vec[Key comparable, Type constraints.Integer] struct {
+	factory  func() (*Stat[Type], error)
+	limit    int
+	overflow Key
+
+	mutex   sync.RWMutex
+	keys    []Key
+	entries map[Key]*vecEntry[Type]
+}
+
+type vecEntry[Type constraints.Integer] struct {
+	mutex sync.Mutex
+	stat  *Stat[Type]
+}
+
+// Creates a vector of statistics.
+//
+// Statistics are created by the specified factory function, which must create
+// statistics with the same spans, for example:
+//
+//	func() (*stat.Stat[int], error) {
+//		return stat.NewLinear(1, 100, 10)
+//	}
+//
+// Factory function should not create expandable statistics (by
+// [NewExpandableLinear] or [NewExpandableExponential]), their spans are
+// expanded independently for each key and once they diverge, aggregation of
+// statistics fails with the [ErrLayoutsMismatch] error.
+//
+// Quantity of keys is limited by the specified limit, occurrences for keys
+// beyond the limit are counted in statistics of the overflow key, which is not
+// taken into account in the limit. Zero limit means no limit.
+func NewVec[Key comparable, Type constraints.Integer](
+	factory func() (*Stat[Type], error),
+	limit int,
+	overflow Key,
+) (*Vec[Key, Type], error) {
+	if factory == nil {
+		return nil, ErrFactoryNil
+	}
+
+	if limit < 0 {
+		return nil, ErrLimitNegative
+	}
+
+	vec := &Vec[Key, Type]{
+		factory:  factory,
+		limit:    limit,
+		overflow: overflow,
+		entries:  make(map[Key]*vecEntry[Type]),
+	}
+
+	return vec, nil
+}
+
+// Increases the quantity of occurrences of the specified value in statistics of
+// the specified key.
+//
+// Error is returned only if the statistics cannot be created by the factory
+// function.
+func (vec *Vec[Key, Type]) Inc(key Key, value Type) error {
+	ntr, err := vec.entry(key)
+	if err != nil {
+		return err
+	}
+
+	ntr.mutex.Lock()
+	defer ntr.mutex.Unlock()
+
+	ntr.stat.Inc(value)
+
+	return nil
+}
+
+func (vec *Vec[Key, Type]) entry(key Key) (*vecEntry[Type], error) {
+	vec.mutex.RLock()
+	ntr, exists := vec.entries[key]
+	vec.mutex.RUnlock()
+
+	if exists {
+		return ntr, nil
+	}
+
+	vec.mutex.Lock()
+	defer vec.mutex.Unlock()
+
+	// Entry could be created while the lock was released
+	if ntr, exists := vec.entries[key]; exists {
+		return ntr, nil
+	}
+
+	if key != vec.overflow && vec.limit != 0 && vec.cardinality() >= vec.limit {
+		key = vec.overflow
+
+		if ntr, exists := vec.entries[key]; exists {
+			return ntr, nil
+		}
+	}
+
+	stat, err := vec.factory()
+	if err != nil {
+		return nil, err
+	}
+
+	ntr = &vecEntry[Type]{stat: stat}
+
+	vec.entries[key] = ntr
+	vec.keys = append(vec.keys, key)
+
+	return ntr, nil
+}
+
+// Returns the quantity of keys taken into account in the limit.
+func (vec *Vec[Key, Type]) cardinality() int {
+	if _, exists := vec.entries[vec.overflow]; exists {
+		return len(vec.entries) - 1
+	}
+
+	return len(vec.entries)
+}
+
+// Returns a list of keys in the order of their first use. Overflow key is
+// included if occurrences have been counted for it.
+func (vec *Vec[Key, Type]) Keys() []Key {
+	vec.mutex.RLock()
+	defer vec.mutex.RUnlock()
+
+	return slices.Clone(vec.keys)
+}
+
+// Returns statistics aggregated over the specified keys or over all keys if
+// none are specified. Keys without statistics are ignored.
+//
+// Returned statistics is a copy and is not changed by subsequent increments. On
+// overflow of the quantity of occurrences it behaves according to the overflow
+// mode of statistics created by the factory, in the [OverflowError] mode the
+// [ErrQuantityOverflow] error is returned.
+func (vec *Vec[Key, Type]) Aggregate(keys ...Key) (*Stat[Type], error) {
+	aggregate, err := vec.factory()
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate = aggregate.layout()
+
+	vec.mutex.RLock()
+	defer vec.mutex.RUnlock()
+
+	if len(keys) == 0 {
+		keys = vec.keys
+	}
+
+	for _, key := range keys {
+		ntr, exists := vec.entries[key]
+		if !exists {
+			continue
+		}
+
+		if err := ntr.mergeTo(aggregate); err != nil {
+			return nil, err
+		}
+	}
+
+	return aggregate, nil
+}
+
+func (ntr *vecEntry[Type]) mergeTo(aggregate *Stat[Type]) error {
+	ntr.mutex.Lock()
+	defer ntr.mutex.Unlock()
+
+	return aggregate.merge(ntr.stat)
+}
+
+// Returns a list of statistics items aggregated over the specified keys or over
+// all keys if none are specified.
+func (vec *Vec[Key, Type]) Items(keys ...Key) ([]Item[Type], error) {
+	aggregate, err := vec.Aggregate(keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregate.Items(), nil
+}
+
+// Writes statistics aggregated over the specified keys or over all keys if none
+// are specified as a bar chart to the specified writer, see [Stat.GraphWith].
+func (vec *Vec[Key, Type]) GraphWith(writer io.Writer, keys []Key, opts ...GraphOption) error {
+	aggregate, err := vec.Aggregate(keys...)
+	if err != nil {
+		return err
+	}
+
+	return aggregate.GraphWith(writer, opts...)
+}
@@ -0,0 +1,174 @@
+package stat
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/akramarenkov/span"
+	"github.com/stretchr/testify/require"
+)
+
+func newVecTestFactory() func() (*Stat[int], error) {
+	return func() (*Stat[int], error) {
+		return NewLinear(1, 20, 10)
+	}
+}
+
+func TestVec(t *testing.T) {
+	vec, err := NewVec(newVecTestFactory(), 2, "other")
+	require.NoError(t, err)
+
+	require.NoError(t, vec.Inc("/a", 1))
+	require.NoError(t, vec.Inc("/a", 11))
+	require.NoError(t, vec.Inc("/b", 12))
+	require.NoError(t, vec.Inc("/c", 0))
+	require.NoError(t, vec.Inc("/d", 21))
+	require.NoError(t, vec.Inc("other", 2))
+	require.NoError(t, vec.Inc("/b", 13))
+
+	require.Equal(t, []string{"/a", "/b", "other"}, vec.Keys())
+
+	items, err := vec.Items()
+	require.NoError(t, err)
+
+	expected := []Item[int]{
+		{Kind: ItemKindNegInf, Quantity: 1, Span: span.Span[int]{Begin: math.MinInt, End: 0}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 3, Span: span.Span[int]{Begin: 11, End: 20}},
+		{Kind: ItemKindPosInf, Quantity: 1, Span: span.Span[int]{Begin: 21, End: math.MaxInt}},
+	}
+
+	require.Equal(t, expected, items)
+
+	items, err = vec.Items("/b", "/unknown")
+	require.NoError(t, err)
+
+	expected = []Item[int]{
+		{Kind: ItemKindRegular, Quantity: 0, Span: span.Span[int]{Begin: 1, End: 10}},
+		{Kind: ItemKindRegular, Quantity: 2, Span: span.Span[int]{Begin: 11, End: 20}},
+	}
+
+	require.Equal(t, expected, items)
+
+	aggregate, err := vec.Aggregate("/a")
+	require.NoError(t, err)
+
+	require.NoError(t, vec.Inc("/a", 1))
+	require.Equal(t, uint64(1), aggregate.Items()[0].Quantity)
+
+	buffer := new(strings.Builder)
+
+	require.NoError(t, vec.GraphWith(buffer, nil))
+	require.NotEmpty(t, buffer.String())
+
+	subset := new(strings.Builder)
+
+	require.NoError(t, vec.GraphWith(subset, []string{"/a"}))
+	require.NotEqual(t, buffer.String(), subset.String())
+}
+
+func TestVecUnlimited(t *testing.T) {
+	vec, err := NewVec(newVecTestFactory(), 0, 0)
+	require.NoError(t, err)
+
+	for key := range 100 {
+		require.NoError(t, vec.Inc(key, key))
+	}
+
+	require.Len(t, vec.Keys(), 100)
+}
+
+func TestVecConcurrency(t *testing.T) {
+	vec, err := NewVec(newVecTestFactory(), 4, -1)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	errs := make(chan error, 8)
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for value := range 1000 {
+				if err := vec.Inc(worker, value%20+1); err != nil {
+					errs <- err
+					return
+				}
+			}
+
+			_, err := vec.Items()
+			errs <- err
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Len(t, vec.Keys(), 5)
+
+	summary, err := vec.Aggregate()
+	require.NoError(t, err)
+	require.Equal(t, uint64(8000), summary.Summary().Total)
+}
+
+func TestVecError(t *testing.T) {
+	_, err := NewVec[string, int](nil, 0, "")
+	require.ErrorIs(t, err, ErrFactoryNil)
+
+	_, err = NewVec(newVecTestFactory(), -1, "")
+	require.ErrorIs(t, err, ErrLimitNegative)
+
+	errFactory := errors.New("factory error")
+	fail := false
+
+	factory := func() (*Stat[int], error) {
+		if fail {
+			return nil, errFactory
+		}
+
+		return NewLinear(1, 20, 5)
+	}
+
+	vec, err := NewVec(factory, 0, "")
+	require.NoError(t, err)
+
+	require.NoError(t, vec.Inc("a", 1))
+
+	fail = true
+
+	require.ErrorIs(t, vec.Inc("b", 1), errFactory)
+
+	_, err = vec.Items()
+	require.ErrorIs(t, err, errFactory)
+
+	require.ErrorIs(t, vec.GraphWith(nil, nil), errFactory)
+
+	fail = false
+
+	require.Error(t, vec.GraphWith(nil, nil, WithWidth(-1)))
+
+	layouts := 0
+
+	mismatched := func() (*Stat[int], error) {
+		layouts++
+
+		return NewLinear(1, 20, layouts)
+	}
+
+	vec, err = NewVec(mismatched, 0, "")
+	require.NoError(t, err)
+
+	require.NoError(t, vec.Inc("a", 1))
+
+	_, err = vec.Items()
+	require.ErrorIs(t, err, ErrLayoutsMismatch)
+}